@@ -38,6 +38,7 @@ type AWSClient struct {
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
+	identityStoreRetryBudget  int // From provider configuration.
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	s3ExpressClient           *s3_sdkv2.Client
@@ -193,6 +194,8 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"session":          c.Session,
 	}
 	switch servicePackageName {
+	case names.IdentityStore:
+		m["identitystore_retry_budget"] = c.identityStoreRetryBudget
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -74,6 +75,35 @@ func DiagError(service, action, resource, id string, gotError error) diag.Diagno
 	}
 }
 
+// AppendDiagErrorWithCode is AppendDiagError, with the API error code of gotError,
+// if any, included in the diagnostic's detail
+func AppendDiagErrorWithCode(diags diag.Diagnostics, service, action, resource, id string, gotError error) diag.Diagnostics {
+	return append(diags,
+		diagErrorWithCode(ProblemStandardMessage(service, action, resource, id, gotError), gotError),
+	)
+}
+
+// DiagErrorfWithCode is diag.Errorf, with the API error code of err, if any,
+// included in the diagnostic's detail so that it can be matched on reliably
+func DiagErrorfWithCode(err error, format string, a ...any) diag.Diagnostics {
+	return diag.Diagnostics{
+		diagErrorWithCode(fmt.Sprintf(format, a...), err),
+	}
+}
+
+func diagErrorWithCode(summary string, err error) diag.Diagnostic {
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+	}
+
+	if code := errs.ErrorCode(err); code != "" {
+		d.Detail = fmt.Sprintf("API error code: %s", code)
+	}
+
+	return d
+}
+
 func diagError(service, action, resource, id string, gotError error) diag.Diagnostic {
 	return diag.Diagnostic{
 		Severity: diag.Error,
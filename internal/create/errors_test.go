@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestDiagErrorfWithCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err            error
+		expectedDetail string
+	}{
+		"API error": {
+			err:            fmt.Errorf("operation error: %w", &smithy.GenericAPIError{Code: "NoSuchAccessPoint", Message: "test"}),
+			expectedDetail: "API error code: NoSuchAccessPoint",
+		},
+		"not an API error": {
+			err: errors.New("validation error"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := DiagErrorfWithCode(testCase.err, "reading S3 Access Point (%s): %s", "test", testCase.err)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("got %d diagnostics, expected %d", got, want)
+			}
+
+			if got, want := diags[0].Summary, fmt.Sprintf("reading S3 Access Point (test): %s", testCase.err); got != want {
+				t.Errorf("got summary %q, expected %q", got, want)
+			}
+
+			if got, want := diags[0].Detail, testCase.expectedDetail; got != want {
+				t.Errorf("got detail %q, expected %q", got, want)
+			}
+		})
+	}
+}
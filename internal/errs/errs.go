@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
 )
 
 // errorMessager is a simple interface for types with ErrorMessage().
@@ -77,3 +78,18 @@ func As[T error](err error) (T, bool) {
 	ok := errors.As(err, &as)
 	return as, ok
 }
+
+// ErrorCode returns the API error code (e.g. "ConflictException") carried by err,
+// or an empty string if err doesn't wrap an API error.
+func ErrorCode(err error) string {
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		return apiErr.ErrorCode()
+	}
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+
+	return ""
+}
@@ -4,8 +4,12 @@
 package errs_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -51,3 +55,43 @@ func TestIsAErrorMessageContains(t *testing.T) {
 		t.Error("unexpected false")
 	}
 }
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"nil": {
+			err: nil,
+		},
+		"plain": {
+			err: errors.New("test"),
+		},
+		"smithy": {
+			err:      &smithy.GenericAPIError{Code: "ConflictException", Message: "test"},
+			expected: "ConflictException",
+		},
+		"wrapped smithy": {
+			err:      fmt.Errorf("wrapped: %w", &smithy.GenericAPIError{Code: "ValidationException", Message: "test"}),
+			expected: "ValidationException",
+		},
+		"awserr": {
+			err:      awserr.New("ThrottlingException", "test", nil),
+			expected: "ThrottlingException",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.ErrorCode(testCase.err), testCase.expected; got != want {
+				t.Errorf("ErrorCode() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -80,6 +80,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"identitystore_retry_budget": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of retries made by Identity Store API calls. Can also be configured using the `TF_AWS_IDENTITYSTORE_RETRY_BUDGET` environment variable.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -105,6 +105,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"identitystore_retry_budget": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The maximum number of retries made by Identity Store API calls. " +
+					"Can also be configured using the `TF_AWS_IDENTITYSTORE_RETRY_BUDGET` environment variable.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -481,6 +487,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
+		IdentityStoreRetryBudget:       d.Get("identitystore_retry_budget").(int),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func TestDataSourcePropertyFromResourceProperty(t *testing.T) {
+	t.Parallel()
+
+	rs := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	ds := DataSourcePropertyFromResourceProperty(rs)
+
+	if !ds.Computed || ds.Required || ds.MaxItems != 0 {
+		t.Errorf("unexpected list schema: %#v", ds)
+	}
+
+	elem, ok := ds.Elem.(*schema.Resource)
+	if !ok {
+		t.Fatalf("got Elem %T, expected *schema.Resource", ds.Elem)
+	}
+
+	name := elem.Schema["name"]
+	if !name.Computed || name.Required || name.ForceNew || name.ValidateFunc != nil {
+		t.Errorf("unexpected name schema: %#v", name)
+	}
+
+	values := elem.Schema["values"]
+	if got, want := values.Type, schema.TypeSet; got != want {
+		t.Errorf("got values type %s, expected %s", got, want)
+	}
+	if elem, ok := values.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+		t.Errorf("unexpected values Elem: %#v", values.Elem)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+// Exports for use in tests only.
+var (
+	BuildUpdateOperations          = buildUpdateOperations
+	CreateUser                     = createUser
+	DeleteUser                     = deleteUser
+	FakeError                      = fakeError
+	FakeOperation                  = fakeOperation
+	FakeResponses                  = fakeResponses
+	FindGroupMembershipByID        = findGroupMembershipByID
+	FindGroupMembershipMemberID    = findGroupMembershipMemberID
+	FindGroupMembershipsByGroupID  = findGroupMembershipsByGroupID
+	FindUserByName                 = findUserByName
+	FindUserIDByUserName           = findUserIDByUserName
+	FindUserOrRecreatedUser        = findUserOrRecreatedUser
+	NewFakeClient                  = newFakeClient
+	ResourceGroupMembershipParseID = resourceGroupMembershipParseID
+	ResourceUserFlatten            = resourceUserFlatten
+	ResourceUserParseID            = resourceUserParseID
+	UpdateUser                     = updateUser
+	UserNameFilter                 = userNameFilter
+	UserRetryable                  = userRetryable
+)
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// fakeResponder answers a request to a client from newFakeClient. It's passed
+// the request, whose operation is in the X-Amz-Target header, and returns the
+// response's status code and body.
+type fakeResponder func(r *http.Request) (int, string)
+
+// newFakeHTTPClient returns an HTTP client whose requests are answered by
+// respond, without calling AWS.
+func newFakeHTTPClient(respond fakeResponder) smithyhttp.ClientDoFunc {
+	return func(r *http.Request) (*http.Response, error) {
+		statusCode, body := respond(r)
+
+		return &http.Response{
+			StatusCode: statusCode,
+			Header: http.Header{
+				"Content-Type": []string{"application/x-amz-json-1.1"},
+			},
+			Body: io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}
+}
+
+// newFakeClient returns an Identity Store client whose requests are answered
+// by respond, without calling AWS or retrying in the SDK.
+func newFakeClient(respond fakeResponder, optFns ...func(*identitystore.Options)) *identitystore.Client {
+	return identitystore.New(identitystore.Options{
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  newFakeHTTPClient(respond),
+		Region:      names.USEast1RegionID,
+		Retryer:     aws.NopRetryer{},
+	}, optFns...)
+}
+
+// fakeResponses returns a responder that succeeds with each of the given
+// bodies in turn, repeating the last one. It also returns the number of
+// requests made.
+func fakeResponses(bodies ...string) (fakeResponder, *atomic.Int32) {
+	var requests atomic.Int32
+
+	return func(*http.Request) (int, string) {
+		return http.StatusOK, bodies[min(int(requests.Add(1)), len(bodies))-1]
+	}, &requests
+}
+
+// fakeError returns a responder that fails every request with the given
+// error type.
+func fakeError(errorType string) fakeResponder {
+	return func(*http.Request) (int, string) {
+		return http.StatusBadRequest, fmt.Sprintf(`{"__type":%q,"Message":"test"}`, errorType)
+	}
+}
+
+// fakeOperation returns the name of the operation of a request to a client
+// from newFakeClient.
+func fakeOperation(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "AWSIdentityStore.")
+}
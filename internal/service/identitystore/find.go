@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// userEmptyResultTimeout bounds how long DescribeUser is retried when it
+// returns neither an error nor a user.
+const userEmptyResultTimeout = 5 * time.Second
+
+func FindUserByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, userID string) (*identitystore.DescribeUserOutput, error) {
+	in := &identitystore.DescribeUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	}
+
+	// An empty response without an error is occasionally seen for users that
+	// exist. Retry it briefly so that a one-off blip isn't treated as the user
+	// having been deleted.
+	return tfresource.RetryGWhen(ctx, userEmptyResultTimeout,
+		func() (*identitystore.DescribeUserOutput, error) {
+			return findUser(ctx, conn, in)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*tfresource.EmptyResultError](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+}
+
+func findUser(ctx context.Context, conn *identitystore.Client, in *identitystore.DescribeUserInput) (*identitystore.DescribeUserOutput, error) {
+	out, err := conn.DescribeUser(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		// Name the identity store and user so that it's clear which user went
+		// missing when several identity stores are managed.
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+			Message:     fmt.Sprintf("IdentityStore User (%s) not found in identity store (%s): %s", aws.ToString(in.UserId), aws.ToString(in.IdentityStoreId), err),
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.UserId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// findUserOrRecreatedUser finds a user by ID. If the user no longer exists but
+// another user with the same user name does, e.g. because the user was deleted
+// and created again outside Terraform, that user is returned instead.
+func findUserOrRecreatedUser(ctx context.Context, conn *identitystore.Client, identityStoreID, userID, userName string) (*identitystore.DescribeUserOutput, error) {
+	out, err := FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if !tfresource.NotFound(err) || userName == "" {
+		return out, err
+	}
+
+	newUserID, lookupErr := findUserIDByUserName(ctx, conn, identityStoreID, userName)
+
+	if tfresource.NotFound(lookupErr) {
+		return nil, err
+	}
+
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+
+	return FindUserByTwoPartKey(ctx, conn, identityStoreID, newUserID)
+}
+
+func findUserIDByUserName(ctx context.Context, conn *identitystore.Client, identityStoreID, userName string) (string, error) {
+	return findUserIDByUniqueAttribute(ctx, conn, identityStoreID, "UserName", userName)
+}
+
+func findUserIDByUniqueAttribute(ctx context.Context, conn *identitystore.Client, identityStoreID, attributePath, attributeValue string) (string, error) {
+	in := &identitystore.GetUserIdInput{
+		AlternateIdentifier: &types.AlternateIdentifierMemberUniqueAttribute{
+			Value: types.UniqueAttribute{
+				AttributePath:  aws.String(attributePath),
+				AttributeValue: document.NewLazyDocument(attributeValue),
+			},
+		},
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	out, err := conn.GetUserId(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if out == nil || aws.ToString(out.UserId) == "" {
+		return "", tfresource.NewEmptyResultError(in)
+	}
+
+	return aws.ToString(out.UserId), nil
+}
+
+// findUserByName finds a user by user name with ListUsers. Unlike
+// findUserIDByUserName, which uses GetUserId, it returns the user's attributes.
+func findUserByName(ctx context.Context, conn *identitystore.Client, identityStoreID, userName string) (*types.User, error) {
+	in := &identitystore.ListUsersInput{
+		Filters:         []types.Filter{userNameFilter(userName)},
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	output, err := findUsers(ctx, conn, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// userNameFilter returns a ListUsers filter that matches the user with the
+// specified user name.
+func userNameFilter(userName string) types.Filter {
+	return types.Filter{
+		AttributePath:  aws.String("UserName"),
+		AttributeValue: aws.String(userName),
+	}
+}
+
+func findUsers(ctx context.Context, conn *identitystore.Client, in *identitystore.ListUsersInput) ([]types.User, error) {
+	var output []types.User
+
+	pages := identitystore.NewListUsersPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Users...)
+	}
+
+	return output, nil
+}
+
+func findGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) ([]types.GroupMembership, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	var output []types.GroupMembership
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GroupMemberships...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestUserNameFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := tfidentitystore.UserNameFilter("example.com/jdoe")
+
+	if got, want := aws.ToString(filter.AttributePath), "UserName"; got != want {
+		t.Errorf("AttributePath = %q, want %q", got, want)
+	}
+
+	if got, want := aws.ToString(filter.AttributeValue), "example.com/jdoe"; got != want {
+		t.Errorf("AttributeValue = %q, want %q", got, want)
+	}
+}
+
+func TestFindUserByName(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "1234567890-12345678-1234-1234-1234-123456789012"
+		userName        = "jdoe"
+	)
+
+	user := fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q,"UserName":%q}`, identityStoreID, userID, userName)
+
+	testCases := map[string]struct {
+		pages          []string
+		expectNotFound bool
+		expectTooMany  bool
+	}{
+		"found": {
+			pages: []string{fmt.Sprintf(`{"Users":[%s]}`, user)},
+		},
+		"found on second page": {
+			pages: []string{
+				`{"Users":[],"NextToken":"token"}`,
+				fmt.Sprintf(`{"Users":[%s]}`, user),
+			},
+		},
+		"not found": {
+			pages:          []string{`{"Users":[]}`},
+			expectNotFound: true,
+		},
+		"too many": {
+			pages: []string{
+				fmt.Sprintf(`{"Users":[%s],"NextToken":"token"}`, user),
+				fmt.Sprintf(`{"Users":[%s]}`, user),
+			},
+			expectTooMany: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				requests []identitystore.ListUsersInput
+			)
+
+			conn := tfidentitystore.NewFakeClient(func(r *http.Request) (int, string) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				var in identitystore.ListUsersInput
+				if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+					return http.StatusBadRequest, fmt.Sprintf(`{"__type":"ValidationException","Message":%q}`, err)
+				}
+				requests = append(requests, in)
+
+				return http.StatusOK, testCase.pages[len(requests)-1]
+			})
+
+			output, err := tfidentitystore.FindUserByName(ctx, conn, identityStoreID, userName)
+
+			if got, want := len(requests), len(testCase.pages); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			for _, in := range requests {
+				if got, want := aws.ToString(in.IdentityStoreId), identityStoreID; got != want {
+					t.Errorf("IdentityStoreId = %q, want %q", got, want)
+				}
+
+				if len(in.Filters) != 1 || aws.ToString(in.Filters[0].AttributePath) != "UserName" || aws.ToString(in.Filters[0].AttributeValue) != userName {
+					t.Errorf("unexpected Filters: %v", in.Filters)
+				}
+			}
+
+			switch {
+			case testCase.expectNotFound:
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got: %v", err)
+				}
+			case testCase.expectTooMany:
+				if !errs.IsA[*tfresource.TooManyResultsError](err) {
+					t.Fatalf("expected TooManyResultsError, got: %v", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got := aws.ToString(output.UserId); got != userID {
+					t.Errorf("UserId = %q, want %q", got, userID)
+				}
+			}
+		})
+	}
+}
+
+func TestFindUserByName_resourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfidentitystore.FindUserByName(context.Background(), tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException")), "d-1234567890", "jdoe")
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+}
+
+func TestFindGroupMembershipsByGroupID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	respond, requests := tfidentitystore.FakeResponses(
+		`{"GroupMemberships":[{"MembershipId":"m-1","MemberId":{"UserId":"u-1"}}],"NextToken":"token"}`,
+		`{"GroupMemberships":[{"MembershipId":"m-2","MemberId":{"UserId":"u-2"}}]}`,
+	)
+	conn := tfidentitystore.NewFakeClient(respond)
+
+	output, err := tfidentitystore.FindGroupMembershipsByGroupID(ctx, conn, "d-1234567890", "g-1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := requests.Load(), int32(2); got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+
+	if got, want := len(output), 2; got != want {
+		t.Fatalf("got %d memberships, want %d", got, want)
+	}
+
+	for i, want := range []string{"m-1", "m-2"} {
+		if got := aws.ToString(output[i].MembershipId); got != want {
+			t.Errorf("MembershipId[%d] = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestFindGroupMembershipsByGroupID_notFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfidentitystore.FindGroupMembershipsByGroupID(context.Background(), tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException")), "d-1234567890", "g-1")
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+}
@@ -4,6 +4,8 @@
 package identitystore
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
@@ -50,7 +52,7 @@ func flattenAddress(apiObject *types.Address) map[string]interface{} {
 }
 
 func expandAddress(tfMap map[string]interface{}) *types.Address {
-	if tfMap == nil {
+	if tfMap == nil || addressIsEmpty(tfMap) {
 		return nil
 	}
 
@@ -89,6 +91,81 @@ func expandAddress(tfMap map[string]interface{}) *types.Address {
 	return a
 }
 
+// addressIsEmpty returns whether an addresses block has no attributes set,
+// e.g. an empty "addresses {}" block in configuration.
+func addressIsEmpty(tfMap map[string]interface{}) bool {
+	for _, v := range tfMap {
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				return false
+			}
+		case bool:
+			if v {
+				return false
+			}
+		default:
+			if v != nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// addressKeyAttributes identifies an address for reorderToMatch.
+var addressKeyAttributes = []string{"country", "formatted", "locality", "postal_code", "region", "street_address", "type"}
+
+// reorderToMatch returns tfList ordered like prior, matching entries on the
+// values of the given string attributes. Entries of tfList that aren't in
+// prior follow, in their original order. This keeps an API that returns the
+// same entries in a different order from producing a diff.
+func reorderToMatch(tfList, prior []interface{}, keyAttributes ...string) []interface{} {
+	if len(tfList) < 2 || len(prior) == 0 {
+		return tfList
+	}
+
+	key := func(v interface{}) string {
+		tfMap, _ := v.(map[string]interface{})
+		parts := make([]string, len(keyAttributes))
+
+		for i, k := range keyAttributes {
+			parts[i], _ = tfMap[k].(string)
+		}
+
+		return strings.Join(parts, "\x00")
+	}
+
+	// Duplicate entries are matched in order.
+	indexes := make(map[string][]int)
+	for i, v := range tfList {
+		k := key(v)
+		indexes[k] = append(indexes[k], i)
+	}
+
+	result := make([]interface{}, 0, len(tfList))
+	used := make([]bool, len(tfList))
+
+	for _, v := range prior {
+		k := key(v)
+
+		if is := indexes[k]; len(is) > 0 {
+			result = append(result, tfList[is[0]])
+			used[is[0]] = true
+			indexes[k] = is[1:]
+		}
+	}
+
+	for i, v := range tfList {
+		if !used[i] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 func flattenAddresses(apiObjects []types.Address) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -104,6 +181,28 @@ func flattenAddresses(apiObjects []types.Address) []interface{} {
 	return l
 }
 
+// flattenAddressesKeepEmpty flattens apiObjects. Empty addresses blocks are
+// never sent to the API, so any empty blocks in tfList, e.g. from
+// "addresses {}" in configuration, are kept at their position and the API's
+// addresses fill the other positions in order. State then matches
+// configuration whether or not empty blocks are present.
+func flattenAddressesKeepEmpty(apiObjects []types.Address, tfList []interface{}) []interface{} {
+	addresses := flattenAddresses(apiObjects)
+
+	var l []interface{}
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok && addressIsEmpty(tfMap) {
+			l = append(l, tfMap)
+		} else if len(addresses) > 0 {
+			l = append(l, addresses[0])
+			addresses = addresses[1:]
+		}
+	}
+
+	return append(l, addresses...)
+}
+
 func expandAddresses(tfList []interface{}) []types.Address {
 	s := make([]types.Address, 0, len(tfList))
 
@@ -395,6 +494,20 @@ func flattenPhoneNumbers(apiObjects []types.PhoneNumber) []interface{} {
 	return l
 }
 
+// reconcileImplicitPrimaryPhoneNumber treats a user's only phone number as
+// primary if it was primary before, as the API can omit primary for it.
+func reconcileImplicitPrimaryPhoneNumber(tfList []interface{}, primary bool) []interface{} {
+	if len(tfList) != 1 || !primary {
+		return tfList
+	}
+
+	if tfMap, ok := tfList[0].(map[string]interface{}); ok {
+		tfMap["primary"] = true
+	}
+
+	return tfList
+}
+
 func expandPhoneNumbers(tfList []interface{}) []types.PhoneNumber {
 	s := make([]types.PhoneNumber, 0, len(tfList))
 
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenExternalIds(t *testing.T) {
+	t.Parallel()
+
+	// External IDs are only populated for users and groups provisioned via
+	// SCIM, so they can't be exercised by the acceptance tests.
+	apiObjects := []types.ExternalId{
+		{
+			Id:     aws.String("00u1a2b3c4d5e6f7g8h9"),
+			Issuer: aws.String("https://example.okta.com"),
+		},
+		{
+			Id:     aws.String("8d2c7e1a-0b3f-4c5d-9e6f-7a8b9c0d1e2f"),
+			Issuer: aws.String("https://login.microsoftonline.com/example"),
+		},
+	}
+
+	testCases := map[string]*schema.Resource{
+		"group":             ResourceGroup(),
+		"group data source": DataSourceGroup(),
+		"user":              ResourceUser(),
+		"user data source":  DataSourceUser(),
+	}
+
+	for name, r := range testCases {
+		r := r
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := r.TestResourceData()
+
+			if err := d.Set("external_ids", flattenExternalIds(apiObjects)); err != nil {
+				t.Fatalf("setting external_ids: %s", err)
+			}
+
+			if got, want := d.Get("external_ids.#").(int), len(apiObjects); got != want {
+				t.Fatalf("got %d external IDs, expected %d", got, want)
+			}
+
+			for i, apiObject := range apiObjects {
+				tfMap := d.Get("external_ids").([]interface{})[i].(map[string]interface{})
+
+				if got, want := tfMap["id"], aws.ToString(apiObject.Id); got != want {
+					t.Errorf("external_ids.%d.id = %#v, expected %#v", i, got, want)
+				}
+
+				if got, want := tfMap["issuer"], aws.ToString(apiObject.Issuer); got != want {
+					t.Errorf("external_ids.%d.issuer = %#v, expected %#v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandAddresses(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList   []interface{}
+		expected int
+	}{
+		"empty block": {
+			// An empty "addresses {}" block.
+			tfList: []interface{}{
+				map[string]interface{}{
+					"country":        "",
+					"formatted":      "",
+					"locality":       "",
+					"postal_code":    "",
+					"primary":        false,
+					"region":         "",
+					"street_address": "",
+					"type":           "",
+				},
+			},
+			expected: 0,
+		},
+		"primary only": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"country":        "",
+					"formatted":      "",
+					"locality":       "",
+					"postal_code":    "",
+					"primary":        true,
+					"region":         "",
+					"street_address": "",
+					"type":           "",
+				},
+			},
+			expected: 1,
+		},
+		"country": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"country":        "US",
+					"formatted":      "",
+					"locality":       "",
+					"postal_code":    "",
+					"primary":        false,
+					"region":         "",
+					"street_address": "",
+					"type":           "",
+				},
+			},
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := len(expandAddresses(testCase.tfList)), testCase.expected; got != want {
+				t.Errorf("got %d addresses, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFlattenAddressesKeepEmpty(t *testing.T) {
+	t.Parallel()
+
+	address := func(country string) map[string]interface{} {
+		return map[string]interface{}{
+			"country":        country,
+			"formatted":      "",
+			"locality":       "",
+			"postal_code":    "",
+			"primary":        false,
+			"region":         "",
+			"street_address": "",
+			"type":           "",
+		}
+	}
+
+	testCases := map[string]struct {
+		apiObjects []types.Address
+		tfList     []interface{}
+		expected   []string
+	}{
+		"no addresses": {},
+		"empty block": {
+			tfList:   []interface{}{address("")},
+			expected: []string{""},
+		},
+		"address removed outside of Terraform": {
+			tfList: []interface{}{address("US")},
+		},
+		"address added outside of Terraform": {
+			apiObjects: []types.Address{{Country: aws.String("US")}},
+			tfList:     []interface{}{address("")},
+			expected:   []string{"", "US"},
+		},
+		"address without prior state": {
+			apiObjects: []types.Address{{Country: aws.String("US")}},
+			expected:   []string{"US"},
+		},
+		"empty blocks mixed with addresses": {
+			apiObjects: []types.Address{{Country: aws.String("US")}, {Country: aws.String("CA")}},
+			tfList:     []interface{}{address("US"), address(""), address("CA"), address("")},
+			expected:   []string{"US", "", "CA", ""},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flattenAddressesKeepEmpty(testCase.apiObjects, testCase.tfList)
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("got %d addresses, expected %d", len(got), len(testCase.expected))
+			}
+
+			for i, want := range testCase.expected {
+				if got := got[i].(map[string]interface{})["country"]; got != want {
+					t.Errorf("addresses.%d.country = %#v, expected %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenExpandEmails(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []types.Email{
+		{
+			Primary: true,
+			Type:    aws.String("work"),
+			Value:   aws.String("jdoe@example.com"),
+		},
+		{
+			Primary: false,
+			Type:    aws.String("home"),
+			Value:   aws.String("john.doe@example.org"),
+		},
+	}
+
+	d := ResourceUser().TestResourceData()
+
+	if err := d.Set("emails", flattenEmails(apiObjects)); err != nil {
+		t.Fatalf("setting emails: %s", err)
+	}
+
+	got := expandEmails(d.Get("emails").([]interface{}))
+
+	if len(got) != len(apiObjects) {
+		t.Fatalf("got %d emails, expected %d", len(got), len(apiObjects))
+	}
+
+	for i, want := range apiObjects {
+		if got[i].Primary != want.Primary {
+			t.Errorf("emails.%d.primary = %t, expected %t", i, got[i].Primary, want.Primary)
+		}
+
+		if got, want := aws.ToString(got[i].Type), aws.ToString(want.Type); got != want {
+			t.Errorf("emails.%d.type = %q, expected %q", i, got, want)
+		}
+
+		if got, want := aws.ToString(got[i].Value), aws.ToString(want.Value); got != want {
+			t.Errorf("emails.%d.value = %q, expected %q", i, got, want)
+		}
+	}
+}
+
+func TestReconcileImplicitPrimaryPhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	phoneNumber := func(primary bool) map[string]interface{} {
+		return map[string]interface{}{
+			"primary": primary,
+			"type":    "work",
+			"value":   "+1 206 555 0100",
+		}
+	}
+
+	testCases := map[string]struct {
+		apiObjects   []types.PhoneNumber
+		priorPrimary bool
+		expected     []bool
+	}{
+		"no phone numbers": {
+			priorPrimary: true,
+		},
+		"single phone number read back without primary": {
+			// Regression: a phone number created with primary = true and read
+			// back with primary omitted must not produce a diff.
+			apiObjects: []types.PhoneNumber{
+				{Type: aws.String("work"), Value: aws.String("+1 206 555 0100")},
+			},
+			priorPrimary: true,
+			expected:     []bool{true},
+		},
+		"single phone number not previously primary": {
+			apiObjects: []types.PhoneNumber{
+				{Type: aws.String("work"), Value: aws.String("+1 206 555 0100")},
+			},
+			priorPrimary: false,
+			expected:     []bool{false},
+		},
+		"multiple phone numbers": {
+			apiObjects: []types.PhoneNumber{
+				{Type: aws.String("work"), Value: aws.String("+1 206 555 0100")},
+				{Type: aws.String("home"), Value: aws.String("+1 503 555 0100")},
+			},
+			priorPrimary: true,
+			expected:     []bool{false, false},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := reconcileImplicitPrimaryPhoneNumber(flattenPhoneNumbers(testCase.apiObjects), testCase.priorPrimary)
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("got %d phone numbers, expected %d", len(got), len(testCase.expected))
+			}
+
+			for i, want := range testCase.expected {
+				if got := got[i].(map[string]interface{})["primary"]; got != want {
+					t.Errorf("phone_numbers.%d.primary = %#v, expected %t", i, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("flag on resource", func(t *testing.T) {
+		t.Parallel()
+
+		d := ResourceUser().TestResourceData()
+		d.Set("implicit_primary_phone_number", true)
+		d.Set("phone_numbers", []interface{}{phoneNumber(true)})
+
+		tfList := reconcileImplicitPrimaryPhoneNumber(flattenPhoneNumbers([]types.PhoneNumber{{Type: aws.String("work"), Value: aws.String("+1 206 555 0100")}}), d.Get("phone_numbers.0.primary").(bool))
+
+		if err := d.Set("phone_numbers", tfList); err != nil {
+			t.Fatalf("setting phone_numbers: %s", err)
+		}
+
+		if !d.Get("phone_numbers.0.primary").(bool) {
+			t.Error("expected phone_numbers.0.primary to remain true")
+		}
+	})
+}
+
+func TestReorderToMatch(t *testing.T) {
+	t.Parallel()
+
+	email := func(typ, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"primary": false,
+			"type":    typ,
+			"value":   value,
+		}
+	}
+	values := func(tfList []interface{}) []string {
+		var s []string
+		for _, v := range tfList {
+			tfMap := v.(map[string]interface{})
+			s = append(s, tfMap["type"].(string)+":"+tfMap["value"].(string))
+		}
+		return s
+	}
+
+	testCases := map[string]struct {
+		tfList   []interface{}
+		prior    []interface{}
+		expected []string
+	}{
+		"no prior": {
+			tfList:   []interface{}{email("work", "b"), email("home", "a")},
+			expected: []string{"work:b", "home:a"},
+		},
+		"reordered by API": {
+			tfList:   []interface{}{email("home", "a"), email("work", "b")},
+			prior:    []interface{}{email("work", "b"), email("home", "a")},
+			expected: []string{"work:b", "home:a"},
+		},
+		"new entry": {
+			tfList:   []interface{}{email("other", "c"), email("home", "a"), email("work", "b")},
+			prior:    []interface{}{email("work", "b"), email("home", "a")},
+			expected: []string{"work:b", "home:a", "other:c"},
+		},
+		"removed entry": {
+			tfList:   []interface{}{email("home", "a")},
+			prior:    []interface{}{email("work", "b"), email("home", "a")},
+			expected: []string{"home:a"},
+		},
+		"same value different type": {
+			tfList:   []interface{}{email("home", "a"), email("work", "a")},
+			prior:    []interface{}{email("work", "a"), email("home", "a")},
+			expected: []string{"work:a", "home:a"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := reorderToMatch(testCase.tfList, testCase.prior, "type", "value")
+
+			if got, want := strings.Join(values(got), ","), strings.Join(testCase.expected, ","); got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+}
@@ -44,10 +44,10 @@ func ResourceGroup() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
 			"display_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 1024),
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validDisplayName,
 			},
 			"external_ids": {
 				Type:     schema.TypeList,
@@ -70,9 +70,10 @@ func ResourceGroup() *schema.Resource {
 				Computed: true,
 			},
 			"identity_store_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validIdentityStoreID,
 			},
 		},
 	}
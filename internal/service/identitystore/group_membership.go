@@ -47,16 +47,50 @@ func ResourceGroupMembership() *schema.Resource {
 			},
 
 			"identity_store_id": {
-				Type:         schema.TypeString,
-				Required:     true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validIdentityStoreID,
+			},
+
+			"member": {
+				Type:         schema.TypeList,
+				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 36),
+				MaxItems:     1,
+				ExactlyOneOf: []string{"member", "member_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unique_attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_path": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"attribute_value": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 
 			"member_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
+				ExactlyOneOf: []string{"member", "member_id"},
 				ValidateFunc: validation.StringLenBetween(1, 47),
 			},
 
@@ -84,6 +118,14 @@ func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	if v, ok := d.GetOk("member_id"); ok {
 		input.MemberId = &types.MemberIdMemberUserId{Value: v.(string)}
+	} else if v, ok := d.GetOk("member"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		memberID, err := findGroupMembershipMemberID(ctx, conn, identityStoreId, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameGroupMembership, identityStoreId, err)
+		}
+
+		input.MemberId = &types.MemberIdMemberUserId{Value: memberID}
 	}
 
 	out, err := conn.CreateGroupMembership(ctx, input)
@@ -176,6 +218,27 @@ func getMemberIdMemberUserId(memberId types.MemberId) (*string, error) {
 	}
 }
 
+// findGroupMembershipMemberID resolves the user ID identified by the unique
+// attribute in a "member" block.
+func findGroupMembershipMemberID(ctx context.Context, conn *identitystore.Client, identityStoreID string, tfMap map[string]interface{}) (string, error) {
+	v, ok := tfMap["unique_attribute"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return "", errors.New("member.0.unique_attribute is required")
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	attributePath, attributeValue := tfMap["attribute_path"].(string), tfMap["attribute_value"].(string)
+
+	memberID, err := findUserIDByUniqueAttribute(ctx, conn, identityStoreID, attributePath, attributeValue)
+
+	if err != nil {
+		return "", fmt.Errorf("resolving member %s (%s): %w", attributePath, attributeValue, err)
+	}
+
+	return memberID, nil
+}
+
 func resourceGroupMembershipParseID(id string) (identityStoreId, groupMembershipId string, err error) {
 	parts := strings.Split(id, "/")
 
@@ -19,9 +19,126 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestResourceGroupMembershipParseID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                      string
+		expectedIdentityStoreID string
+		expectedMembershipID    string
+		expectError             bool
+	}{
+		"empty": {
+			id:          "",
+			expectError: true,
+		},
+		"missing membership ID": {
+			id:          "d-1234567890/",
+			expectError: true,
+		},
+		"missing identity store ID": {
+			id:          "/00000000-0000-0000-0000-000000000000",
+			expectError: true,
+		},
+		"too many parts": {
+			id:          "d-1234567890/00000000-0000-0000-0000-000000000000/extra",
+			expectError: true,
+		},
+		"valid": {
+			id:                      "d-1234567890/00000000-0000-0000-0000-000000000000",
+			expectedIdentityStoreID: "d-1234567890",
+			expectedMembershipID:    "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			identityStoreID, membershipID, err := tfidentitystore.ResourceGroupMembershipParseID(testCase.id)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if identityStoreID != testCase.expectedIdentityStoreID {
+				t.Errorf("got identity store ID %q, expected %q", identityStoreID, testCase.expectedIdentityStoreID)
+			}
+
+			if membershipID != testCase.expectedMembershipID {
+				t.Errorf("got membership ID %q, expected %q", membershipID, testCase.expectedMembershipID)
+			}
+		})
+	}
+}
+
+func TestFindGroupMembershipByID_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException"))
+
+	_, err := tfidentitystore.FindGroupMembershipByID(ctx, conn, "d-1234567890", "00000000-0000-0000-0000-000000000000")
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+}
+
+func TestFindGroupMembershipMemberID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "1234567890-12345678-1234-1234-1234-123456789012"
+	)
+
+	member := map[string]interface{}{
+		"unique_attribute": []interface{}{
+			map[string]interface{}{
+				"attribute_path":  "UserName",
+				"attribute_value": "jdoe",
+			},
+		},
+	}
+
+	respond, _ := tfidentitystore.FakeResponses(fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q}`, identityStoreID, userID))
+	conn := tfidentitystore.NewFakeClient(respond)
+
+	output, err := tfidentitystore.FindGroupMembershipMemberID(ctx, conn, identityStoreID, member)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != userID {
+		t.Errorf("member ID = %q, want %q", output, userID)
+	}
+
+	_, err = tfidentitystore.FindGroupMembershipMemberID(ctx, tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException")), identityStoreID, member)
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("expected NotFound error, got: %v", err)
+	}
+
+	_, err = tfidentitystore.FindGroupMembershipMemberID(ctx, conn, identityStoreID, map[string]interface{}{})
+
+	if err == nil {
+		t.Error("expected error for missing unique_attribute")
+	}
+}
+
 func TestAccIdentityStoreGroupMembership_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var groupMembership identitystore.DescribeGroupMembershipOutput
@@ -181,6 +298,43 @@ func TestAccIdentityStoreGroupMembership_MemberId(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreGroupMembership_memberUniqueAttribute(t *testing.T) {
+	ctx := acctest.Context(t)
+	var groupMembership identitystore.DescribeGroupMembershipOutput
+	resourceName := "aws_identitystore_group_membership.test"
+	userResourceName := "aws_identitystore_user.test"
+	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_memberUniqueAttribute(groupName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipExists(ctx, resourceName, &groupMembership),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "member.0.unique_attribute.0.attribute_path", "UserName"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", userResourceName, "user_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member"},
+			},
+		},
+	})
+}
+
 func testAccCheckGroupMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
@@ -267,3 +421,39 @@ resource "aws_identitystore_group_membership" "test" {
 }
 `, userName, groupName)
 }
+
+func testAccGroupMembershipConfig_memberUniqueAttribute(groupName, userName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[2]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_group_membership" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+
+  member {
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = aws_identitystore_user.test.user_name
+    }
+  }
+}
+`, userName, groupName)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameGroupMemberships = "Group Memberships Data Source"
+)
+
+// @SDKDataSource("aws_identitystore_group_memberships")
+func DataSourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGroupMembershipsRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"group_memberships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_store_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validIdentityStoreID,
+			},
+		},
+	}
+}
+
+func dataSourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := fmt.Sprintf("%s/%s", identityStoreID, groupID)
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, DSNameGroupMemberships, id, err)
+	}
+
+	tfList := make([]interface{}, 0, len(memberships))
+
+	for _, apiObject := range memberships {
+		tfMap := map[string]interface{}{
+			"membership_id": aws.ToString(apiObject.MembershipId),
+		}
+
+		if v, ok := apiObject.MemberId.(*types.MemberIdMemberUserId); ok {
+			tfMap["member_id"] = v.Value
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(id)
+	if err := d.Set("group_memberships", tfList); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, DSNameGroupMemberships, id, err)
+	}
+
+	return diags
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMembershipsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_identitystore_group_memberships.test"
+	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsDataSourceConfig_basic(groupName, userName1, userName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_id", "aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_memberships.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "group_memberships.*.member_id", "aws_identitystore_user.test1", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "group_memberships.*.member_id", "aws_identitystore_user.test2", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "group_memberships.*.membership_id", "aws_identitystore_group_membership.test1", "membership_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "group_memberships.*.membership_id", "aws_identitystore_group_membership.test2", "membership_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipsDataSourceConfig_basic(groupName, userName1, userName2 string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+locals {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = local.identity_store_id
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_user" "test1" {
+  identity_store_id = local.identity_store_id
+
+  display_name = "Acceptance Test"
+  user_name    = %[2]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_user" "test2" {
+  identity_store_id = local.identity_store_id
+
+  display_name = "Acceptance Test"
+  user_name    = %[3]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "Jane"
+  }
+}
+
+resource "aws_identitystore_group_membership" "test1" {
+  identity_store_id = local.identity_store_id
+  group_id          = aws_identitystore_group.test.group_id
+  member_id         = aws_identitystore_user.test1.user_id
+}
+
+resource "aws_identitystore_group_membership" "test2" {
+  identity_store_id = local.identity_store_id
+  group_id          = aws_identitystore_group.test.group_id
+  member_id         = aws_identitystore_user.test2.user_id
+}
+
+data "aws_identitystore_group_memberships" "test" {
+  identity_store_id = local.identity_store_id
+  group_id          = aws_identitystore_group.test.group_id
+
+  depends_on = [
+    aws_identitystore_group_membership.test1,
+    aws_identitystore_group_membership.test2,
+  ]
+}
+`, groupName, userName1, userName2)
+}
@@ -21,6 +21,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFindGroupByTwoPartKey_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException"))
+
+	_, err := tfidentitystore.FindGroupByTwoPartKey(ctx, conn, "d-1234567890", "00000000-0000-0000-0000-000000000000")
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+}
+
 func TestAccIdentityStoreGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group identitystore.DescribeGroupOutput
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	ssoadmintypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_identitystore")
+func DataSourceIdentityStore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIdentityStoreRead,
+
+		Schema: map[string]*schema.Schema{
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+
+	instance, err := findIdentityStoreInstance(ctx, client.SSOAdminClient(ctx), client.AccountID, client.Region)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Identity Store", err))
+	}
+
+	identityStoreID := aws.ToString(instance.IdentityStoreId)
+
+	// Confirm that the identity store itself is reachable.
+	_, err = client.IdentityStoreClient(ctx).ListGroups(ctx, &identitystore.ListGroupsInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MaxResults:      aws.Int32(1),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Identity Store (%s): %s", identityStoreID, err)
+	}
+
+	d.SetId(identityStoreID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("instance_arn", instance.InstanceArn)
+	d.Set("region", client.Region)
+
+	return diags
+}
+
+// identityStoreInstances caches, by account ID and Region, the SSO instance
+// that the identity store is connected to. An account has at most one
+// instance per Region, and it can't be replaced without recreating the
+// identity store, so the lookup is made once per provider process.
+var identityStoreInstances = struct {
+	sync.Mutex
+	m map[string]*ssoadmintypes.InstanceMetadata
+}{
+	m: make(map[string]*ssoadmintypes.InstanceMetadata),
+}
+
+func findIdentityStoreInstance(ctx context.Context, conn *ssoadmin.Client, accountID, region string) (*ssoadmintypes.InstanceMetadata, error) {
+	key := accountID + "/" + region
+
+	identityStoreInstances.Lock()
+	v, ok := identityStoreInstances.m[key]
+	identityStoreInstances.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	// The lock isn't held while listing instances, so that a slow or throttled
+	// call doesn't block lookups for other accounts and Regions. Concurrent
+	// lookups for the same key may each list instances; they find the same one.
+	instance, err := findInstance(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	identityStoreInstances.Lock()
+	identityStoreInstances.m[key] = instance
+	identityStoreInstances.Unlock()
+
+	return instance, nil
+}
+
+func findInstance(ctx context.Context, conn *ssoadmin.Client) (*ssoadmintypes.InstanceMetadata, error) {
+	input := &ssoadmin.ListInstancesInput{}
+	var output []ssoadmintypes.InstanceMetadata
+
+	pages := ssoadmin.NewListInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Instances...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
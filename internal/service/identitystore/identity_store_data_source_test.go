@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreIdentityStoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_identitystore.test"
+	instancesDataSourceName := "data.aws_ssoadmin_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityStoreDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_store_id", instancesDataSourceName, "identity_store_ids.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_arn", instancesDataSourceName, "arns.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+const testAccIdentityStoreDataSourceConfig_basic = `
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore" "test" {}
+`
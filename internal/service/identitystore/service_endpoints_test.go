@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestEndpointConfiguration_partitions(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testcases := map[string]struct {
+		region      string
+		with        []setupFunc
+		endpoint    string
+		expectError *regexp.Regexp
+	}{
+		"standard FIPS": {
+			region:   "us-west-2", //lintignore:AWSAT003
+			with:     []setupFunc{withUseFIPSInConfig},
+			endpoint: "https://identitystore-fips.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+		},
+
+		"GovCloud": {
+			region:   "us-gov-west-1", //lintignore:AWSAT003
+			with:     []setupFunc{withNoConfig},
+			endpoint: "https://identitystore.us-gov-west-1.amazonaws.com/", //lintignore:AWSAT003
+		},
+
+		// The GovCloud endpoints are FIPS compliant, so there is no separate
+		// FIPS hostname.
+		"GovCloud FIPS": {
+			region:   "us-gov-west-1", //lintignore:AWSAT003
+			with:     []setupFunc{withUseFIPSInConfig},
+			endpoint: "https://identitystore.us-gov-west-1.amazonaws.com/", //lintignore:AWSAT003
+		},
+
+		"China": {
+			region:   "cn-north-1", //lintignore:AWSAT003
+			with:     []setupFunc{withNoConfig},
+			endpoint: "https://identitystore.cn-north-1.amazonaws.com.cn/", //lintignore:AWSAT003
+		},
+
+		"China FIPS": {
+			region:   "cn-north-1", //lintignore:AWSAT003
+			with:     []setupFunc{withUseFIPSInConfig},
+			endpoint: "https://identitystore-fips.cn-north-1.amazonaws.com.cn/", //lintignore:AWSAT003
+		},
+
+		// The endpoint rules reject a custom endpoint combined with FIPS
+		// rather than letting either one take precedence.
+		"endpoint config with FIPS": {
+			region: "us-gov-west-1", //lintignore:AWSAT003
+			with: []setupFunc{
+				withUseFIPSInConfig,
+				withPackageNameEndpointInConfig,
+			},
+			expectError: regexache.MustCompile(`FIPS and custom endpoint are not supported`),
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest // uses t.Setenv
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			callF := callService
+			if testcase.expectError != nil {
+				callF = callServiceExpectError(testcase.expectError)
+			}
+
+			testEndpointCase(t, testcase.region, endpointTestCase{
+				with: testcase.with,
+				expected: caseExpectations{
+					endpoint: testcase.endpoint,
+				},
+			}, callF)
+		})
+	}
+}
+
+// callServiceExpectError returns a callFunc that expects endpoint resolution
+// to fail with an error matching expectError. No endpoint is resolved, so the
+// returned endpoint is always empty.
+func callServiceExpectError(expectError *regexp.Regexp) callFunc {
+	return func(ctx context.Context, t *testing.T, meta *conns.AWSClient) string {
+		t.Helper()
+
+		client := meta.IdentityStoreClient(ctx)
+
+		_, err := client.ListUsers(ctx, &identitystore.ListUsersInput{
+			IdentityStoreId: aws.String("d-1234567890"),
+		})
+
+		if err == nil {
+			t.Fatal("Expected an error, got none")
+		} else if !expectError.MatchString(err.Error()) {
+			t.Fatalf("Expected an error matching %q, got: %s", expectError, err)
+		}
+
+		return ""
+	}
+}
+
+func withUseFIPSInConfig(setup *caseSetup) {
+	setup.config["use_fips_endpoint"] = true
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+)
+
+const (
+	// retryBudgetConfigKey is the provider argument that caps the total number
+	// of retries made by the Identity Store client of a provider configuration.
+	// When the whole identity store is throttled, retrying every request
+	// independently only deepens the throttling.
+	retryBudgetConfigKey = "identitystore_retry_budget"
+
+	// retryBudgetEnvVar is the environment variable that caps the total number
+	// of retries made by all Identity Store clients during a run. It's used
+	// when retryBudgetConfigKey isn't set.
+	retryBudgetEnvVar = "TF_AWS_IDENTITYSTORE_RETRY_BUDGET"
+)
+
+var (
+	// envRetryBudget is shared by every Identity Store client that doesn't
+	// have its own budget, across provider configurations and Regions.
+	envRetryBudget     *ratelimit.TokenRateLimit
+	envRetryBudgetErr  error
+	envRetryBudgetOnce sync.Once
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*identitystore.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	budget, err := retryBudget(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var retryer aws.RetryerV2
+	if budget != nil {
+		v, ok := cfg.Retryer().(aws.RetryerV2)
+		if !ok {
+			return nil, fmt.Errorf("retry budget: unsupported Identity Store retryer type %T", cfg.Retryer())
+		}
+
+		retryer = newRetryBudgetRetryer(v, budget)
+	}
+
+	return identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+
+		if retryer != nil {
+			o.Retryer = retryer
+		}
+	}), nil
+}
+
+// retryBudget returns the retry budget configured by retryBudgetConfigKey or,
+// failing that, retryBudgetEnvVar. It returns nil if neither is configured.
+func retryBudget(config map[string]any) (*ratelimit.TokenRateLimit, error) {
+	if v, ok := config[retryBudgetConfigKey].(int); ok && v != 0 {
+		if v < 0 {
+			return nil, fmt.Errorf("%s (%d) must be a positive integer", retryBudgetConfigKey, v)
+		}
+
+		return ratelimit.NewTokenRateLimit(uint(v)), nil
+	}
+
+	envRetryBudgetOnce.Do(func() {
+		envRetryBudget, envRetryBudgetErr = newRetryBudgetFromEnv()
+	})
+
+	return envRetryBudget, envRetryBudgetErr
+}
+
+// newRetryBudgetFromEnv returns the retry budget configured by retryBudgetEnvVar,
+// or nil if none is configured.
+func newRetryBudgetFromEnv() (*ratelimit.TokenRateLimit, error) {
+	v := os.Getenv(retryBudgetEnvVar)
+	if v == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseUint(v, 10, 0)
+	if err != nil || n == 0 {
+		return nil, fmt.Errorf("%s (%s) must be a positive integer", retryBudgetEnvVar, v)
+	}
+
+	return ratelimit.NewTokenRateLimit(uint(n)), nil
+}
+
+// retryBudgetRetryer takes one token from a shared budget for every retry. Unlike
+// the SDK's default retry quota, tokens aren't refunded when a retry succeeds,
+// so the budget is the maximum number of retries made during a run.
+type retryBudgetRetryer struct {
+	aws.RetryerV2
+	budget *ratelimit.TokenRateLimit
+}
+
+func newRetryBudgetRetryer(retryer aws.RetryerV2, budget *ratelimit.TokenRateLimit) aws.RetryerV2 {
+	return &retryBudgetRetryer{
+		RetryerV2: retryer,
+		budget:    budget,
+	}
+}
+
+func (r *retryBudgetRetryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	if _, err := r.budget.GetToken(ctx, 1); err != nil {
+		// opErr isn't wrapped so that callers don't retry it, e.g. as a
+		// ThrottlingException, once the budget is spent.
+		return nil, fmt.Errorf("retry budget exhausted (%w), last error: %s", err, opErr)
+	}
+
+	return r.RetryerV2.GetRetryToken(ctx, opErr)
+}
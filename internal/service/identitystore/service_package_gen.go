@@ -5,8 +5,6 @@ package identitystore
 import (
 	"context"
 
-	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
-	identitystore_sdkv2 "github.com/aws/aws-sdk-go-v2/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -24,10 +22,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceIdentityStore,
+			TypeName: "aws_identitystore",
+		},
 		{
 			Factory:  DataSourceGroup,
 			TypeName: "aws_identitystore_group",
 		},
+		{
+			Factory:  DataSourceGroupMemberships,
+			TypeName: "aws_identitystore_group_memberships",
+		},
 		{
 			Factory:  DataSourceUser,
 			TypeName: "aws_identitystore_user",
@@ -56,17 +62,6 @@ func (p *servicePackage) ServicePackageName() string {
 	return names.IdentityStore
 }
 
-// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
-func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*identitystore_sdkv2.Client, error) {
-	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
-
-	return identitystore_sdkv2.NewFromConfig(cfg, func(o *identitystore_sdkv2.Options) {
-		if endpoint := config["endpoint"].(string); endpoint != "" {
-			o.BaseEndpoint = aws_sdkv2.String(endpoint)
-		}
-	}), nil
-}
-
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewClientEndpointOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "1234567890-12345678-1234-1234-1234-123456789012"
+	)
+
+	const endpoint = "https://identitystore.example.com"
+
+	var requestURL, operation string
+	cfg := aws.Config{
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: newFakeHTTPClient(func(r *http.Request) (int, string) {
+			requestURL, operation = r.URL.String(), fakeOperation(r)
+
+			return http.StatusOK, fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q}`, identityStoreID, userID)
+		}),
+		Region: names.USEast1RegionID,
+		Retryer: func() aws.Retryer {
+			return aws.NopRetryer{}
+		},
+	}
+
+	conn, err := (&servicePackage{}).NewClient(ctx, map[string]any{
+		"aws_sdkv2_config": &cfg,
+		"endpoint":         endpoint,
+	})
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	output, err := createUser(ctx, conn, &identitystore.CreateUserInput{
+		DisplayName:     aws.String("John Doe"),
+		IdentityStoreId: aws.String(identityStoreID),
+		Name: &types.Name{
+			FamilyName: aws.String("Doe"),
+			GivenName:  aws.String("John"),
+		},
+		UserName: aws.String("jdoe"),
+	}, time.Minute)
+
+	if err != nil {
+		t.Fatalf("creating user: %s", err)
+	}
+
+	if got, want := aws.ToString(output.UserId), userID; got != want {
+		t.Errorf("UserId = %q, want %q", got, want)
+	}
+
+	if got, want := requestURL, endpoint+"/"; got != want {
+		t.Errorf("request URL = %q, want %q", got, want)
+	}
+
+	if got, want := operation, "CreateUser"; got != want {
+		t.Errorf("endpoint received %q, want %q", got, want)
+	}
+}
+
+func TestRetryBudgetRetryer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var attempts atomic.Int32
+	const budget = 3
+
+	retryer := retry.NewStandard(func(o *retry.StandardOptions) {
+		o.MaxAttempts = 5
+		o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+		o.RateLimiter = ratelimit.None
+	})
+	retryBudget := ratelimit.NewTokenRateLimit(budget)
+
+	throttled := fakeError("ThrottlingException")
+	conn := newFakeClient(func(r *http.Request) (int, string) {
+		attempts.Add(1)
+
+		return throttled(r)
+	}, func(o *identitystore.Options) {
+		o.Retryer = newRetryBudgetRetryer(retryer, retryBudget)
+	})
+
+	// Without a budget, each call would make MaxAttempts attempts.
+	for i := 0; i < 2; i++ {
+		_, err := conn.DescribeUser(ctx, &identitystore.DescribeUserInput{
+			IdentityStoreId: aws.String("d-1234567890"),
+			UserId:          aws.String("00000000-0000-0000-0000-000000000000"),
+		})
+
+		if !errs.IsA[ratelimit.QuotaExceededError](err) {
+			t.Fatalf("call %d: expected QuotaExceededError, got: %v", i, err)
+		}
+
+		// Otherwise the resource's own retries would keep on spending the
+		// budget of other requests.
+		if errs.IsA[*types.ThrottlingException](err) {
+			t.Errorf("call %d: expected the ThrottlingException not to be wrapped, got: %v", i, err)
+		}
+
+		if retryable, _ := userRetryable(err); retryable {
+			t.Errorf("call %d: expected error not to be retryable: %v", i, err)
+		}
+	}
+
+	// 2 initial attempts plus the 3 retries allowed by the budget.
+	if got, want := attempts.Load(), int32(2+budget); got != want {
+		t.Errorf("got %d attempts, expected %d", got, want)
+	}
+
+	if got := retryBudget.Remaining(); got != 0 {
+		t.Errorf("got %d retries remaining, expected 0", got)
+	}
+}
+
+func TestNewRetryBudgetFromEnv(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testCases := map[string]struct {
+		value       string
+		expected    uint
+		expectError bool
+	}{
+		"unset": {
+			value: "",
+		},
+		"valid": {
+			value:    "100",
+			expected: 100,
+		},
+		"zero": {
+			value:       "0",
+			expectError: true,
+		},
+		"negative": {
+			value:       "-1",
+			expectError: true,
+		},
+		"not a number": {
+			value:       "lots",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(retryBudgetEnvVar, testCase.value)
+
+			got, err := newRetryBudgetFromEnv()
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expected == 0 {
+				if got != nil {
+					t.Errorf("expected no retry budget, got %d", got.Remaining())
+				}
+				return
+			}
+
+			if got == nil || got.Remaining() != testCase.expected {
+				t.Errorf("expected retry budget of %d", testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       int
+		expected    uint
+		expectError bool
+	}{
+		"valid": {
+			value:    100,
+			expected: 100,
+		},
+		"negative": {
+			value:       -1,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := retryBudget(map[string]any{
+				retryBudgetConfigKey: testCase.value,
+			})
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got == nil || got.Remaining() != testCase.expected {
+				t.Errorf("expected retry budget of %d", testCase.expected)
+			}
+		})
+	}
+}
+
+func TestNewClientRetryBudgetUnsupportedRetryer(t *testing.T) {
+	t.Parallel()
+
+	cfg := aws.Config{
+		Credentials: aws.AnonymousCredentials{},
+		Region:      names.USEast1RegionID,
+		Retryer: func() aws.Retryer {
+			// Hide the aws.RetryerV2 methods of aws.NopRetryer.
+			return struct{ aws.Retryer }{aws.NopRetryer{}}
+		},
+	}
+
+	_, err := (&servicePackage{}).NewClient(context.Background(), map[string]any{
+		"aws_sdkv2_config":   &cfg,
+		"endpoint":           "",
+		retryBudgetConfigKey: 10,
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
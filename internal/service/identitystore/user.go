@@ -8,40 +8,54 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
-	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// userSensitiveContactFieldsEnvVar is the environment variable that opts in to
+// marking the user's contact fields as sensitive. It's opt-in because redacting
+// these values changes existing plan output.
+const userSensitiveContactFieldsEnvVar = "TF_AWS_IDENTITYSTORE_USER_SENSITIVE_CONTACT_FIELDS"
+
 // @SDKResource("aws_identitystore_user")
 func ResourceUser() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
 		ReadWithoutTimeout:   resourceUserRead,
 		UpdateWithoutTimeout: resourceUserUpdate,
 		DeleteWithoutTimeout: resourceUserDelete,
 
+		CustomizeDiff: resourceUserCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceUserImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"addresses": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"country": {
@@ -89,13 +103,19 @@ func ResourceUser() *schema.Resource {
 			},
 			"display_name": {
 				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validDisplayName,
+			},
+			"display_name_from_name": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"name_from_display_name"},
 			},
 			"emails": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"primary": {
@@ -111,7 +131,7 @@ func ResourceUser() *schema.Resource {
 						"value": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
+							ValidateDiagFunc: validation.AllDiag(validation.ToDiagFunc(validation.StringLenBetween(1, 1024)), validEmailAddress),
 						},
 					},
 				},
@@ -132,10 +152,31 @@ func ResourceUser() *schema.Resource {
 					},
 				},
 			},
+			"group_memberships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"identity_store_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validIdentityStoreID,
+			},
+			"ignore_contact_order": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"implicit_primary_phone_number": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_group_memberships": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"locale": {
 				Type:             schema.TypeString,
@@ -144,7 +185,8 @@ func ResourceUser() *schema.Resource {
 			},
 			"name": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -181,6 +223,12 @@ func ResourceUser() *schema.Resource {
 					},
 				},
 			},
+			"name_from_display_name": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"display_name_from_name"},
+			},
 			"nickname": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -189,7 +237,6 @@ func ResourceUser() *schema.Resource {
 			"phone_numbers": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"primary": {
@@ -205,7 +252,7 @@ func ResourceUser() *schema.Resource {
 						"value": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
+							ValidateDiagFunc: validation.AllDiag(validation.ToDiagFunc(validation.StringLenBetween(1, 1024)), validPhoneNumber),
 						},
 					},
 				},
@@ -220,10 +267,18 @@ func ResourceUser() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
 			},
+			"recreate_missing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"timezone": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.AllDiag(
+					validation.ToDiagFunc(validation.StringLenBetween(1, 1024)),
+					validTimezone,
+				),
 			},
 			"title": {
 				Type:             schema.TypeString,
@@ -237,7 +292,6 @@ func ResourceUser() *schema.Resource {
 			"user_name": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 128)),
 			},
 			"user_type": {
@@ -247,6 +301,16 @@ func ResourceUser() *schema.Resource {
 			},
 		},
 	}
+
+	if v, _ := strconv.ParseBool(os.Getenv(userSensitiveContactFieldsEnvVar)); v {
+		for _, s := range r.Schema["addresses"].Elem.(*schema.Resource).Schema {
+			s.Sensitive = true
+		}
+		r.Schema["emails"].Elem.(*schema.Resource).Schema["value"].Sensitive = true
+		r.Schema["phone_numbers"].Elem.(*schema.Resource).Schema["value"].Sensitive = true
+	}
+
+	return r
 }
 
 const (
@@ -265,7 +329,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 {
-		in.Addresses = expandAddresses(v.([]interface{}))
+		if v := expandAddresses(v.([]interface{})); len(v) > 0 {
+			in.Addresses = v
+		}
 	}
 
 	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 {
@@ -276,8 +342,11 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.Locale = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+	if v, ok := d.GetOk("name"); ok && userNameConfigured(v) {
 		in.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	} else if d.Get("name_from_display_name").(bool) {
+		// display_name wasn't known when the name was derived at plan time.
+		in.Name = expandName(defaultUserName(d.Get("display_name").(string)))
 	}
 
 	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 {
@@ -308,9 +377,10 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.UserType = aws.String(v.(string))
 	}
 
-	out, err := conn.CreateUser(ctx, in)
+	out, err := createUser(ctx, conn, in, d.Timeout(schema.TimeoutCreate))
+
 	if err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameUser, d.Get("identity_store_id").(string), err)
+		return create.AppendDiagErrorWithCode(diags, names.IdentityStore, create.ErrActionCreating, ResNameUser, d.Get("identity_store_id").(string), err)
 	}
 
 	if out == nil || out.UserId == nil {
@@ -333,18 +403,58 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameUser, d.Id(), err)
 	}
 
-	out, err := FindUserByTwoPartKey(ctx, conn, identityStoreId, userId)
+	var out *identitystore.DescribeUserOutput
+
+	if d.Get("recreate_missing").(bool) && !d.IsNewResource() {
+		out, err = findUserOrRecreatedUser(ctx, conn, identityStoreId, userId, d.Get("user_name").(string))
+	} else {
+		out, err = FindUserByTwoPartKey(ctx, conn, identityStoreId, userId)
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] IdentityStore User (%s) not found, removing from state", d.Id())
+		log.Printf("[WARN] IdentityStore User (%s) not found, removing from state: %s", d.Id(), err)
 		d.SetId("")
 		return diags
 	}
 
 	if err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameUser, d.Id(), err)
+		return create.AppendDiagErrorWithCode(diags, names.IdentityStore, create.ErrActionReading, ResNameUser, d.Id(), err)
+	}
+
+	if newUserId := aws.ToString(out.UserId); newUserId != userId {
+		log.Printf("[WARN] IdentityStore User (%s) not found, adopting user (%s) with the same user name", d.Id(), newUserId)
+		diags = append(diags, errs.NewWarningDiagnostic(
+			"IdentityStore User recreated outside Terraform",
+			fmt.Sprintf("IdentityStore User (%s) was not found, so user (%s) with the same user name (%s) was adopted in its place. Attributes set outside Terraform on the new user may show as changes.", d.Id(), newUserId, aws.ToString(out.UserName)),
+		))
+		userId = newUserId
+		d.SetId(fmt.Sprintf("%s/%s", identityStoreId, userId))
+	}
+
+	if err := resourceUserFlatten(d, out); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+	}
+
+	// Listing group memberships costs extra API calls, so it's opt-in.
+	if d.Get("include_group_memberships").(bool) {
+		groupIDs, err := findGroupIDsByUserID(ctx, conn, identityStoreId, userId)
+
+		if err != nil {
+			return create.AppendDiagErrorWithCode(diags, names.IdentityStore, create.ErrActionReading, ResNameUser, d.Id(), err)
+		}
+
+		d.Set("group_memberships", groupIDs)
+	} else {
+		d.Set("group_memberships", nil)
 	}
 
+	return diags
+}
+
+// resourceUserFlatten sets the user's attributes from a DescribeUser response.
+// Only attributes in the schema are set, so fields the provider doesn't know
+// about, e.g. ones added to the API later, are ignored.
+func resourceUserFlatten(d *schema.ResourceData, out *identitystore.DescribeUserOutput) error {
 	d.Set("display_name", out.DisplayName)
 	d.Set("identity_store_id", out.IdentityStoreId)
 	d.Set("locale", out.Locale)
@@ -357,27 +467,42 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("user_name", out.UserName)
 	d.Set("user_type", out.UserType)
 
-	if err := d.Set("addresses", flattenAddresses(out.Addresses)); err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+	addresses := flattenAddressesKeepEmpty(out.Addresses, d.Get("addresses").([]interface{}))
+	emails := flattenEmails(out.Emails)
+	phoneNumbers := flattenPhoneNumbers(out.PhoneNumbers)
+
+	// The API doesn't guarantee the order of these lists.
+	if d.Get("ignore_contact_order").(bool) {
+		addresses = reorderToMatch(addresses, d.Get("addresses").([]interface{}), addressKeyAttributes...)
+		emails = reorderToMatch(emails, d.Get("emails").([]interface{}), "type", "value")
+		phoneNumbers = reorderToMatch(phoneNumbers, d.Get("phone_numbers").([]interface{}), "type", "value")
 	}
 
-	if err := d.Set("emails", flattenEmails(out.Emails)); err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+	if err := d.Set("addresses", addresses); err != nil {
+		return err
+	}
+
+	if err := d.Set("emails", emails); err != nil {
+		return err
 	}
 
 	if err := d.Set("external_ids", flattenExternalIds(out.ExternalIds)); err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+		return err
 	}
 
 	if err := d.Set("name", []interface{}{flattenName(out.Name)}); err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+		return err
 	}
 
-	if err := d.Set("phone_numbers", flattenPhoneNumbers(out.PhoneNumbers)); err != nil {
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionSetting, ResNameUser, d.Id(), err)
+	if d.Get("implicit_primary_phone_number").(bool) {
+		phoneNumbers = reconcileImplicitPrimaryPhoneNumber(phoneNumbers, d.Get("phone_numbers.0.primary").(bool))
 	}
 
-	return diags
+	if err := d.Set("phone_numbers", phoneNumbers); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -385,288 +510,307 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
 
+	// The name block is always set once a user exists, but it can be missing from corrupted state.
+	// Updating the name.0.* attributes would then unset the user's name.
+	if d.HasChange("name") && !userNameConfigured(d.Get("name")) {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameUser, d.Id(), errors.New("name block is required"))
+	}
+
 	in := &identitystore.UpdateUserInput{
 		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
 		UserId:          aws.String(d.Get("user_id").(string)),
-		Operations:      nil,
-	}
-
-	// IMPLEMENTATION NOTE.
-	//
-	// Complex types, such as the `emails` field, don't allow field by field
-	// updates, and require that the entire sub-object is modified.
-	//
-	// In those sub-objects, to remove a field, it must not be present at all
-	// in the updated attribute value.
-	//
-	// However, structs such as types.Email don't specify omitempty in their
-	// struct tags, so the document.NewLazyDocument marshaller will write out
-	// nulls.
-	//
-	// This is why, for those complex fields, a custom Expand function is
-	// provided that converts the Go SDK type (e.g. types.Email) into a field
-	// by field representation of what the API would expect.
-
-	fieldsToUpdate := []struct {
-		// Attribute corresponds to the provider schema.
-		Attribute string
-
-		// Field corresponds to the AWS API schema.
-		Field string
-
-		// Expand, when not nil, is used to transform the value of the field
-		// given in Attribute before it's passed to the UpdateOperation.
-		Expand func(interface{}) interface{}
-	}{
-		{
-			Attribute: "display_name",
-			Field:     "displayName",
-		},
-		{
-			Attribute: "locale",
-			Field:     "locale",
-		},
-		{
-			Attribute: "name.0.family_name",
-			Field:     "name.familyName",
-		},
-		{
-			Attribute: "name.0.formatted",
-			Field:     "name.formatted",
-		},
-		{
-			Attribute: "name.0.given_name",
-			Field:     "name.givenName",
-		},
-		{
-			Attribute: "name.0.honorific_prefix",
-			Field:     "name.honorificPrefix",
-		},
-		{
-			Attribute: "name.0.honorific_suffix",
-			Field:     "name.honorificSuffix",
-		},
-		{
-			Attribute: "name.0.middle_name",
-			Field:     "name.middleName",
-		},
-		{
-			Attribute: "nickname",
-			Field:     "nickName",
-		},
-		{
-			Attribute: "preferred_language",
-			Field:     "preferredLanguage",
-		},
-		{
-			Attribute: "profile_url",
-			Field:     "profileUrl",
-		},
-		{
-			Attribute: "timezone",
-			Field:     "timezone",
-		},
-		{
-			Attribute: "title",
-			Field:     "title",
-		},
-		{
-			Attribute: "user_type",
-			Field:     "userType",
-		},
-		{
-			Attribute: "addresses",
-			Field:     "addresses",
-			Expand: func(value interface{}) interface{} {
-				addresses := expandAddresses(value.([]interface{}))
+		Operations:      buildUpdateOperations(d, userUpdateFields),
+	}
 
-				var result []interface{}
+	if len(in.Operations) > 0 {
+		log.Printf("[DEBUG] Updating IdentityStore User (%s): %#v", d.Id(), in)
+		err := updateUser(ctx, conn, in, d.Timeout(schema.TimeoutUpdate))
 
-				// The API requires a null to unset the list, so in the case
-				// of no addresses, a nil result is preferable.
-				for _, address := range addresses {
-					m := map[string]interface{}{}
+		if d.HasChange("user_name") && errs.IsA[*types.ConflictException](err) {
+			err = fmt.Errorf("user name %q is in use by another user. To manage that user instead, remove this resource from state and import it: %w", d.Get("user_name").(string), err)
+		}
 
-					if v := address.Country; v != nil {
-						m["country"] = v
-					}
+		if err != nil {
+			return create.AppendDiagErrorWithCode(diags, names.IdentityStore, create.ErrActionUpdating, ResNameUser, d.Id(), updateOperationsError(in.Operations, userUpdateFields, err))
+		}
+	}
 
-					if v := address.Formatted; v != nil {
-						m["formatted"] = v
-					}
+	return append(diags, resourceUserRead(ctx, d, meta)...)
+}
 
-					if v := address.Locality; v != nil {
-						m["locality"] = v
-					}
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-					if v := address.PostalCode; v != nil {
-						m["postalCode"] = v
-					}
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
 
-					m["primary"] = address.Primary
+	log.Printf("[INFO] Deleting IdentityStore User %s", d.Id())
 
-					if v := address.Region; v != nil {
-						m["region"] = v
-					}
+	err := deleteUser(ctx, conn, &identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
+		UserId:          aws.String(d.Get("user_id").(string)),
+	}, d.Timeout(schema.TimeoutDelete))
 
-					if v := address.StreetAddress; v != nil {
-						m["streetAddress"] = v
-					}
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
 
-					if v := address.Type; v != nil {
-						m["type"] = v
-					}
+		return create.AppendDiagErrorWithCode(diags, names.IdentityStore, create.ErrActionDeleting, ResNameUser, d.Id(), err)
+	}
 
-					result = append(result, m)
-				}
+	return diags
+}
 
-				return result
-			},
+func findGroupIDsByUserID(ctx context.Context, conn *identitystore.Client, identityStoreID, userID string) ([]string, error) {
+	in := &identitystore.ListGroupMembershipsForMemberInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId: &types.MemberIdMemberUserId{
+			Value: userID,
 		},
-		{
-			Attribute: "emails",
-			Field:     "emails",
-			Expand: func(value interface{}) interface{} {
-				emails := expandEmails(value.([]interface{}))
-
-				var result []interface{}
+	}
 
-				// The API requires a null to unset the list, so in the case
-				// of no emails, a nil result is preferable.
-				for _, email := range emails {
-					m := map[string]interface{}{}
+	var groupIDs []string
 
-					m["primary"] = email.Primary
+	pages := identitystore.NewListGroupMembershipsForMemberPaginator(conn, in)
 
-					if v := email.Type; v != nil {
-						m["type"] = v
-					}
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-					if v := email.Value; v != nil {
-						m["value"] = v
-					}
+		if err != nil {
+			return nil, err
+		}
 
-					result = append(result, m)
-				}
+		for _, v := range page.GroupMemberships {
+			groupIDs = append(groupIDs, aws.ToString(v.GroupId))
+		}
+	}
 
-				return result
-			},
-		},
-		{
-			Attribute: "phone_numbers",
-			Field:     "phoneNumbers",
-			Expand: func(value interface{}) interface{} {
-				emails := expandPhoneNumbers(value.([]interface{}))
+	return groupIDs, nil
+}
 
-				var result []interface{}
+// userProviderOnlyAttributes only change the provider's behavior. They aren't
+// sent to or returned by the API.
+var userProviderOnlyAttributes = []string{
+	"display_name_from_name",
+	"ignore_contact_order",
+	"implicit_primary_phone_number",
+	"include_group_memberships",
+	"name_from_display_name",
+	"recreate_missing",
+}
 
-				// The API requires a null to unset the list, so in the case
-				// of no emails, a nil result is preferable.
-				for _, email := range emails {
-					m := map[string]interface{}{}
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	identityStoreID, userID, err := resourceUserParseID(d.Id())
 
-					m["primary"] = email.Primary
+	if err != nil {
+		return nil, errors.New("expected an import id in the form: identity-store-id/user-id or identity-store-id/user-name")
+	}
 
-					if v := email.Type; v != nil {
-						m["type"] = v
-					}
+	// Anything that isn't a user ID is a user name, which is resolved to the user's ID.
+	if !verify.IsIdentityStorePrincipalID(userID) {
+		conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+		userName := userID
 
-					if v := email.Value; v != nil {
-						m["value"] = v
-					}
+		userID, err = findUserIDByUserName(ctx, conn, identityStoreID, userName)
 
-					result = append(result, m)
-				}
+		if err != nil {
+			return nil, fmt.Errorf("finding IdentityStore User (%s) in identity store (%s): %w", userName, identityStoreID, err)
+		}
 
-				return result
-			},
-		},
+		d.SetId(fmt.Sprintf("%s/%s", identityStoreID, userID))
 	}
 
-	for _, fieldToUpdate := range fieldsToUpdate {
-		if d.HasChange(fieldToUpdate.Attribute) {
-			value := d.Get(fieldToUpdate.Attribute)
+	// Set the defaults that read can't, so that the first plan after import
+	// only shows real changes.
+	for _, k := range userProviderOnlyAttributes {
+		d.Set(k, false)
+	}
 
-			if expand := fieldToUpdate.Expand; expand != nil {
-				value = expand(value)
-			}
+	return []*schema.ResourceData{d}, nil
+}
 
-			// The API doesn't allow empty attribute values. To unset an
-			// attribute, set it to null.
-			if value == "" {
-				value = nil
-			}
+func resourceUserCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return errors.Join(
+		validateSinglePrimary("addresses", d.Get("addresses").([]interface{})),
+		validateSinglePrimary("emails", d.Get("emails").([]interface{})),
+		validateSinglePrimary("phone_numbers", d.Get("phone_numbers").([]interface{})),
+		resourceUserCustomizeDiffDisplayName(d),
+		resourceUserCustomizeDiffName(d),
+	)
+}
 
-			in.Operations = append(in.Operations, types.AttributeOperation{
-				AttributePath:  aws.String(fieldToUpdate.Field),
-				AttributeValue: document.NewLazyDocument(value),
-			})
+// resourceUserCustomizeDiffDisplayName derives display_name from the name
+// block when it isn't configured and display_name_from_name is set.
+func resourceUserCustomizeDiffDisplayName(d *schema.ResourceDiff) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() {
+		if d.Get("display_name").(string) != "" {
+			return nil
 		}
+	} else if !rawConfig.GetAttr("display_name").IsNull() {
+		return nil
 	}
 
-	if len(in.Operations) > 0 {
-		log.Printf("[DEBUG] Updating IdentityStore User (%s): %#v", d.Id(), in)
-		_, err := conn.UpdateUser(ctx, in)
-		if err != nil {
-			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameUser, d.Id(), err)
+	if !d.Get("display_name_from_name").(bool) {
+		return errors.New(`"display_name" is required unless "display_name_from_name" is true`)
+	}
+
+	for _, key := range []string{"name.0.family_name", "name.0.formatted", "name.0.given_name"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("display_name")
 		}
 	}
 
-	return append(diags, resourceUserRead(ctx, d, meta)...)
+	v, ok := d.Get("name").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	if displayName := defaultUserDisplayName(v[0].(map[string]interface{})); displayName != d.Get("display_name").(string) {
+		return d.SetNew("display_name", displayName)
+	}
+
+	return nil
 }
 
-func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// resourceUserCustomizeDiffName derives the name block from display_name when
+// it isn't configured and name_from_display_name is set. The API requires a
+// name, so the block can only be omitted when it is derived.
+func resourceUserCustomizeDiffName(d *schema.ResourceDiff) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() {
+		if userNameConfigured(d.Get("name")) {
+			return nil
+		}
+	} else if rawUserNameConfigured(rawConfig.GetAttr("name")) {
+		return nil
+	}
 
-	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+	if !d.Get("name_from_display_name").(bool) {
+		return errors.New(`"name" is required unless "name_from_display_name" is true`)
+	}
 
-	log.Printf("[INFO] Deleting IdentityStore User %s", d.Id())
+	if !d.NewValueKnown("display_name") {
+		return d.SetNewComputed("name")
+	}
 
-	_, err := conn.DeleteUser(ctx, &identitystore.DeleteUserInput{
-		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
-		UserId:          aws.String(d.Get("user_id").(string)),
-	})
+	tfMap := defaultUserName(d.Get("display_name").(string))
 
-	if err != nil {
-		var nfe *types.ResourceNotFoundException
-		if errors.As(err, &nfe) {
-			return diags
-		}
+	if v, ok := d.Get("name").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		old := v[0].(map[string]interface{})
 
-		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameUser, d.Id(), err)
+		if old["family_name"] == tfMap["family_name"] && old["given_name"] == tfMap["given_name"] {
+			return nil
+		}
 	}
 
-	return diags
+	return d.SetNew("name", []interface{}{tfMap})
 }
 
-func FindUserByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, userID string) (*identitystore.DescribeUserOutput, error) {
-	in := &identitystore.DescribeUserInput{
-		IdentityStoreId: aws.String(identityStoreID),
-		UserId:          aws.String(userID),
+// defaultUserName returns a name block derived from displayName. The last
+// word is used as the family name and the rest as the given name. A single
+// word is used as both, since the API requires both.
+func defaultUserName(displayName string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"family_name":      "",
+		"formatted":        "",
+		"given_name":       "",
+		"honorific_prefix": "",
+		"honorific_suffix": "",
+		"middle_name":      "",
+	}
+
+	switch words := strings.Fields(displayName); len(words) {
+	case 0:
+	case 1:
+		tfMap["family_name"] = words[0]
+		tfMap["given_name"] = words[0]
+	default:
+		tfMap["family_name"] = words[len(words)-1]
+		tfMap["given_name"] = strings.Join(words[:len(words)-1], " ")
+	}
+
+	return tfMap
+}
+
+// defaultUserDisplayName returns the formatted name if set, otherwise the
+// given and family names separated by a space.
+func defaultUserDisplayName(tfMap map[string]interface{}) string {
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		return v
 	}
 
-	out, err := conn.DescribeUser(ctx, in)
+	givenName, _ := tfMap["given_name"].(string)
+	familyName, _ := tfMap["family_name"].(string)
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: in,
-		}
-	}
+	return strings.TrimSpace(givenName + " " + familyName)
+}
+
+// createUser calls CreateUser, retrying retryable errors until timeout
+// expires. If it does, the last error is returned.
+func createUser(ctx context.Context, conn *identitystore.Client, in *identitystore.CreateUserInput, timeout time.Duration) (*identitystore.CreateUserOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.CreateUser(ctx, in)
+		},
+		userRetryable,
+	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if out == nil || out.UserId == nil {
-		return nil, tfresource.NewEmptyResultError(in)
+	return outputRaw.(*identitystore.CreateUserOutput), nil
+}
+
+// updateUser calls UpdateUser, retrying retryable errors until timeout
+// expires. If it does, the last error is returned.
+func updateUser(ctx context.Context, conn *identitystore.Client, in *identitystore.UpdateUserInput, timeout time.Duration) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.UpdateUser(ctx, in)
+		},
+		userRetryable,
+	)
+
+	return err
+}
+
+// deleteUser calls DeleteUser, retrying retryable errors until timeout
+// expires. If it does, the last error is returned.
+func deleteUser(ctx context.Context, conn *identitystore.Client, in *identitystore.DeleteUserInput, timeout time.Duration) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.DeleteUser(ctx, in)
+		},
+		userRetryable,
+	)
+
+	return err
+}
+
+// userRetryable classifies user API errors that are worth retrying. Identity
+// Store throttles aggressively during bulk provisioning, and returns a
+// ConflictException for concurrent modifications. A ConflictException for a
+// uniqueness constraint violation, e.g. a user name that's already in use,
+// won't resolve itself and isn't retried.
+func userRetryable(err error) (bool, error) {
+	if errs.IsA[*types.ThrottlingException](err) {
+		return true, err
+	}
+
+	if e, ok := errs.As[*types.ConflictException](err); ok && e.Reason == types.ConflictExceptionReasonConcurrentModification {
+		return true, err
 	}
 
-	return out, nil
+	return false, err
 }
 
+// resourceUserParseID splits a resource ID of the form
+// identity-store-id/user-id. Identity Store IDs never contain a slash, so
+// everything after the first slash is returned as the second part. This lets
+// import identifiers that are user names containing slashes round-trip intact.
 func resourceUserParseID(id string) (identityStoreId, userId string, err error) {
-	parts := strings.Split(id, "/")
+	parts := strings.SplitN(id, "/", 2)
 
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		err = errors.New("expected a resource id in the form: identity-store-id/user-id")
@@ -21,6 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// userFilterAttributePaths are the attribute paths that ListUsers supports in
+// filters. Extend this list as the API adds support for more paths.
+var userFilterAttributePaths = []string{
+	"UserName",
+}
+
 // @SDKDataSource("aws_identitystore_user")
 func DataSourceUser() *schema.Resource {
 	return &schema.Resource{
@@ -163,8 +169,9 @@ func DataSourceUser() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"attribute_path": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(userFilterAttributePaths, false),
 						},
 						"attribute_value": {
 							Type:     schema.TypeString,
@@ -297,23 +304,20 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 			Filters:         expandFilters(d.Get("filter").([]interface{})),
 			IdentityStoreId: aws.String(identityStoreID),
 		}
-		paginator := identitystore.NewListUsersPaginator(conn, input)
-		var results []types.User
-
-		for paginator.HasMorePages() {
-			page, err := paginator.NextPage(ctx)
+		users, err := findUsers(ctx, conn, input)
 
-			if err != nil {
-				return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, DSNameUser, identityStoreID, err)
-			}
+		if err != nil {
+			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, DSNameUser, identityStoreID, err)
+		}
 
-			for _, user := range page.Users {
-				if v, ok := d.GetOk("user_id"); ok && v.(string) != aws.ToString(user.UserId) {
-					continue
-				}
+		var results []types.User
 
-				results = append(results, user)
+		for _, user := range users {
+			if v, ok := d.GetOk("user_id"); ok && v.(string) != aws.ToString(user.UserId) {
+				continue
 			}
+
+			results = append(results, user)
 		}
 
 		if len(results) == 0 {
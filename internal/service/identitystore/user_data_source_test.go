@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -136,6 +137,25 @@ func TestAccIdentityStoreUserDataSource_email(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreUserDataSource_alternateIdentifierConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserDataSourceConfig_alternateIdentifierConflict(),
+				ExpectError: regexache.MustCompile(`only one of .alternate_identifier.0.external_id,alternate_identifier.0.unique_attribute. can be specified`),
+			},
+		},
+	})
+}
+
 func TestAccIdentityStoreUserDataSource_userID(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_identitystore_user.test"
@@ -286,6 +306,28 @@ data "aws_identitystore_user" "test" {
 `)
 }
 
+func testAccUserDataSourceConfig_alternateIdentifierConflict() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    external_id {
+      id     = "test"
+      issuer = "test"
+    }
+
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = "test"
+    }
+  }
+}
+`
+}
+
 func testAccUserDataSourceConfig_id(name, email string) string {
 	return acctest.ConfigCompose(testAccUserDataSourceConfig_base(name, email), `
 data "aws_identitystore_user" "test" {
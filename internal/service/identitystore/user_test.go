@@ -5,22 +5,482 @@ package identitystore_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestResourceUserParseID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id                      string
+		expectedIdentityStoreID string
+		expectedUserID          string
+		expectError             bool
+	}{
+		{
+			id:          "",
+			expectError: true,
+		},
+		{
+			id:          "d-1234567890",
+			expectError: true,
+		},
+		{
+			id:          "d-1234567890/",
+			expectError: true,
+		},
+		{
+			id:          "/1234567890-12345678-1234-1234-1234-123456789012",
+			expectError: true,
+		},
+		{
+			id:                      "d-1234567890/1234567890-12345678-1234-1234-1234-123456789012",
+			expectedIdentityStoreID: "d-1234567890",
+			expectedUserID:          "1234567890-12345678-1234-1234-1234-123456789012",
+		},
+		{
+			id:                      "d-1234567890/jdoe",
+			expectedIdentityStoreID: "d-1234567890",
+			expectedUserID:          "jdoe",
+		},
+		{
+			id:                      "d-1234567890/engineering/jdoe",
+			expectedIdentityStoreID: "d-1234567890",
+			expectedUserID:          "engineering/jdoe",
+		},
+		{
+			id:                      "d-1234567890/jdoe/",
+			expectedIdentityStoreID: "d-1234567890",
+			expectedUserID:          "jdoe/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			identityStoreID, userID, err := tfidentitystore.ResourceUserParseID(testCase.id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error parsing %q", testCase.id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", testCase.id, err)
+			}
+
+			if identityStoreID != testCase.expectedIdentityStoreID {
+				t.Errorf("got identity store ID %q, expected %q", identityStoreID, testCase.expectedIdentityStoreID)
+			}
+
+			if userID != testCase.expectedUserID {
+				t.Errorf("got user ID %q, expected %q", userID, testCase.expectedUserID)
+			}
+		})
+	}
+}
+
+func TestResourceUserSensitiveContactFields(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	contactFields := func(r *schema.Resource) map[string]*schema.Schema {
+		m := map[string]*schema.Schema{
+			"emails.0.value":        r.Schema["emails"].Elem.(*schema.Resource).Schema["value"],
+			"phone_numbers.0.value": r.Schema["phone_numbers"].Elem.(*schema.Resource).Schema["value"],
+		}
+		for k, v := range r.Schema["addresses"].Elem.(*schema.Resource).Schema {
+			m["addresses.0."+k] = v
+		}
+
+		return m
+	}
+
+	for k, v := range contactFields(tfidentitystore.ResourceUser()) {
+		if v.Sensitive {
+			t.Errorf("%s is sensitive by default", k)
+		}
+	}
+
+	t.Setenv("TF_AWS_IDENTITYSTORE_USER_SENSITIVE_CONTACT_FIELDS", "true")
+
+	for k, v := range contactFields(tfidentitystore.ResourceUser()) {
+		if !v.Sensitive {
+			t.Errorf("%s is not sensitive when opted in", k)
+		}
+	}
+}
+
+func TestUserRetryable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err:      nil,
+			expected: false,
+		},
+		"ConflictException concurrent modification": {
+			err:      &types.ConflictException{Message: aws.String("concurrent modification"), Reason: types.ConflictExceptionReasonConcurrentModification},
+			expected: true,
+		},
+		"wrapped ConflictException concurrent modification": {
+			err:      fmt.Errorf("operation error: %w", &types.ConflictException{Reason: types.ConflictExceptionReasonConcurrentModification}),
+			expected: true,
+		},
+		"ConflictException uniqueness constraint violation": {
+			err:      &types.ConflictException{Message: aws.String("Duplicate UserName"), Reason: types.ConflictExceptionReasonUniquenessConstraintViolation},
+			expected: false,
+		},
+		"ThrottlingException": {
+			err:      &types.ThrottlingException{Message: aws.String("rate exceeded")},
+			expected: true,
+		},
+		"ValidationException": {
+			err:      &types.ValidationException{Message: aws.String("invalid user name")},
+			expected: false,
+		},
+		"ResourceNotFoundException": {
+			err:      &types.ResourceNotFoundException{},
+			expected: false,
+		},
+		"other error": {
+			err:      errors.New("test"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfidentitystore.UserRetryable(testCase.err)
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+
+			if err != testCase.err { //nolint:errorlint // the error must be passed through unchanged
+				t.Errorf("got error %v, expected %v", err, testCase.err)
+			}
+		})
+	}
+}
+
+func TestUserOperations_throttlingTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ThrottlingException"))
+	const timeout = 100 * time.Millisecond
+
+	testCases := map[string]func() error{
+		"create": func() error {
+			_, err := tfidentitystore.CreateUser(ctx, conn, &identitystore.CreateUserInput{
+				DisplayName:     aws.String("Acceptance Test"),
+				IdentityStoreId: aws.String("d-1234567890"),
+				UserName:        aws.String("test"),
+			}, timeout)
+			return err
+		},
+		"update": func() error {
+			return tfidentitystore.UpdateUser(ctx, conn, &identitystore.UpdateUserInput{
+				IdentityStoreId: aws.String("d-1234567890"),
+				Operations: []types.AttributeOperation{
+					{
+						AttributePath:  aws.String("displayName"),
+						AttributeValue: document.NewLazyDocument("Acceptance Test"),
+					},
+				},
+				UserId: aws.String("00000000-0000-0000-0000-000000000000"),
+			}, timeout)
+		},
+		"delete": func() error {
+			return tfidentitystore.DeleteUser(ctx, conn, &identitystore.DeleteUserInput{
+				IdentityStoreId: aws.String("d-1234567890"),
+				UserId:          aws.String("00000000-0000-0000-0000-000000000000"),
+			}, timeout)
+		},
+	}
+
+	for name, f := range testCases {
+		f := f
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := f()
+
+			if !errs.IsA[*types.ThrottlingException](err) {
+				t.Fatalf("expected ThrottlingException once the timeout expired, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestFindUserByTwoPartKey_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException"))
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "00000000-0000-0000-0000-000000000000"
+	)
+
+	_, err := tfidentitystore.FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+
+	for _, v := range []string{identityStoreID, userID} {
+		if !strings.Contains(err.Error(), v) {
+			t.Errorf("expected error %q to contain %q", err, v)
+		}
+	}
+}
+
+func TestFindUserByTwoPartKey_transientEmptyResult(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "00000000-0000-0000-0000-000000000000"
+	)
+
+	respond, attempts := tfidentitystore.FakeResponses(`{}`, fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q}`, identityStoreID, userID))
+	conn := tfidentitystore.NewFakeClient(respond)
+
+	output, err := tfidentitystore.FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.UserId), userID; got != want {
+		t.Errorf("UserId = %q, want %q", got, want)
+	}
+
+	if got, want := attempts.Load(), int32(2); got != want {
+		t.Errorf("attempts = %d, want %d", got, want)
+	}
+}
+
+func TestResourceUserFlatten_unknownFields(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "00000000-0000-0000-0000-000000000000"
+	)
+
+	// A response with fields that the provider doesn't know about, at the top
+	// level and in nested objects.
+	respond, _ := tfidentitystore.FakeResponses(fmt.Sprintf(`{
+  "IdentityStoreId": %[1]q,
+  "UserId": %[2]q,
+  "UserName": "jdoe",
+  "DisplayName": "John Doe",
+  "Name": {"FamilyName": "Doe", "GivenName": "John", "PhoneticName": "jon doh"},
+  "Emails": [{"Value": "jdoe@example.com", "Primary": true, "Verified": true}],
+  "FutureField": "value",
+  "FutureObject": {"Nested": [1, 2, 3]}
+}`, identityStoreID, userID))
+	conn := tfidentitystore.NewFakeClient(respond)
+
+	output, err := tfidentitystore.FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := tfidentitystore.ResourceUser().TestResourceData()
+	d.SetId(identityStoreID + "/" + userID)
+
+	if err := tfidentitystore.ResourceUserFlatten(d, output); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		"display_name":       "John Doe",
+		"emails.#":           "1",
+		"emails.0.primary":   "true",
+		"emails.0.value":     "jdoe@example.com",
+		"name.0.family_name": "Doe",
+		"name.0.given_name":  "John",
+		"user_id":            userID,
+		"user_name":          "jdoe",
+	} {
+		if got := d.State().Attributes[k]; got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+}
+
+func TestFindUserIDByUserName(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		userID          = "1234567890-12345678-1234-1234-1234-123456789012"
+	)
+
+	respond, requests := tfidentitystore.FakeResponses(fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q}`, identityStoreID, userID))
+	conn := tfidentitystore.NewFakeClient(respond)
+
+	output, err := tfidentitystore.FindUserIDByUserName(ctx, conn, identityStoreID, "example.com/jdoe")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != userID {
+		t.Errorf("UserId = %q, want %q", output, userID)
+	}
+
+	if got, want := requests.Load(), int32(1); got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestFindUserIDByUserName_notFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfidentitystore.FindUserIDByUserName(context.Background(), tfidentitystore.NewFakeClient(tfidentitystore.FakeError("ResourceNotFoundException")), "d-1234567890", "jdoe")
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+}
+
+func TestFindUserOrRecreatedUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		identityStoreID = "d-1234567890"
+		oldUserID       = "1234567890-00000000-0000-0000-0000-000000000000"
+		newUserID       = "1234567890-11111111-1111-1111-1111-111111111111"
+		userName        = "jdoe"
+	)
+
+	notFound := func(string) (int, string) {
+		return http.StatusBadRequest, `{"__type":"ResourceNotFoundException","Message":"test"}`
+	}
+
+	testCases := map[string]struct {
+		responses      map[string]func(userID string) (int, string)
+		expectedUserID string
+		expectNotFound bool
+	}{
+		"user exists": {
+			responses: map[string]func(string) (int, string){
+				"DescribeUser": func(userID string) (int, string) {
+					return http.StatusOK, fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q,"UserName":%q}`, identityStoreID, userID, userName)
+				},
+			},
+			expectedUserID: oldUserID,
+		},
+		"user recreated": {
+			responses: map[string]func(string) (int, string){
+				"DescribeUser": func(userID string) (int, string) {
+					if userID == oldUserID {
+						return notFound(userID)
+					}
+
+					return http.StatusOK, fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q,"UserName":%q}`, identityStoreID, userID, userName)
+				},
+				"GetUserId": func(string) (int, string) {
+					return http.StatusOK, fmt.Sprintf(`{"IdentityStoreId":%q,"UserId":%q}`, identityStoreID, newUserID)
+				},
+			},
+			expectedUserID: newUserID,
+		},
+		"user deleted": {
+			responses: map[string]func(string) (int, string){
+				"DescribeUser": notFound,
+				"GetUserId":    notFound,
+			},
+			expectNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := tfidentitystore.NewFakeClient(func(r *http.Request) (int, string) {
+				var in struct{ UserId string }
+				if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+					return http.StatusBadRequest, fmt.Sprintf(`{"__type":"ValidationException","Message":%q}`, err)
+				}
+
+				operation := tfidentitystore.FakeOperation(r)
+				respond, ok := testCase.responses[operation]
+				if !ok {
+					return http.StatusBadRequest, fmt.Sprintf(`{"__type":"ValidationException","Message":"unexpected operation: %s"}`, operation)
+				}
+
+				return respond(in.UserId)
+			})
+
+			output, err := tfidentitystore.FindUserOrRecreatedUser(ctx, conn, identityStoreID, oldUserID, userName)
+
+			if testCase.expectNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.UserId), testCase.expectedUserID; got != want {
+				t.Errorf("UserId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccIdentityStoreUser_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user identitystore.DescribeUserOutput
@@ -69,6 +529,12 @@ func TestAccIdentityStoreUser_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccUserImportStateIdFuncByUserName(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -101,6 +567,47 @@ func TestAccIdentityStoreUser_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreUser_recreateMissing(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user1, user2 identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_recreateMissing(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user1),
+					resource.TestCheckResourceAttr(resourceName, "recreate_missing", "true"),
+					testAccCheckUserRecreate(ctx, &user1),
+				),
+			},
+			{
+				Config: testAccUserConfig_recreateMissing(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user2),
+					testAccCheckUserRecreated(&user1, &user2),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIdentityStoreUser_Addresses(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user identitystore.DescribeUserOutput
@@ -193,6 +700,52 @@ func TestAccIdentityStoreUser_Addresses(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreUser_addressesEmpty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_addressesEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					testAccCheckUserNoAddresses(&user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.country", ""),
+				),
+			},
+			{
+				Config: testAccUserConfig_addresses1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.country", "US"),
+				),
+			},
+			{
+				Config: testAccUserConfig_addressesEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					testAccCheckUserNoAddresses(&user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.country", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIdentityStoreUser_Emails(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user identitystore.DescribeUserOutput
@@ -273,6 +826,185 @@ func TestAccIdentityStoreUser_Emails(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreUser_importThenAddEmail(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	email := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserConfig_emails1(rName, email),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_emailsMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	email1 := acctest.RandomEmailAddress(acctest.RandomDomainName())
+	email2 := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_emailsMultiple(rName, email1, email2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.type", "work"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email1),
+					resource.TestCheckResourceAttr(resourceName, "emails.1.primary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emails.1.type", "home"),
+					resource.TestCheckResourceAttr(resourceName, "emails.1.value", email2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserConfig_emails1(rName, email1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_ignoreContactOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	email1 := acctest.RandomEmailAddress(acctest.RandomDomainName())
+	email2 := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_ignoreContactOrder(rName, email1, email2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "ignore_contact_order", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email1),
+					resource.TestCheckResourceAttr(resourceName, "emails.1.value", email2),
+				),
+			},
+			{
+				Config:   testAccUserConfig_ignoreContactOrder(rName, email1, email2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_groupMemberships(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+	groupResourceName := "aws_identitystore_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_groupMemberships(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "include_group_memberships", "false"),
+					resource.TestCheckResourceAttr(resourceName, "group_memberships.#", "0"),
+				),
+			},
+			{
+				Config: testAccUserConfig_groupMemberships(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "include_group_memberships", "true"),
+					resource.TestCheckResourceAttr(resourceName, "group_memberships.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_memberships.0", groupResourceName, "group_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group_memberships", "include_group_memberships"},
+			},
+		},
+	})
+}
+
 func TestAccIdentityStoreUser_Locale(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user identitystore.DescribeUserOutput
@@ -579,17 +1311,64 @@ func TestAccIdentityStoreUser_NameMiddleName(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccUserConfig_basic(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_nameFromDisplayName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_nameFromDisplayName(rName, "Jane Public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Jane Public"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.family_name", "Public"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.given_name", "Jane"),
+					resource.TestCheckResourceAttr(resourceName, "name_from_display_name", "true"),
+				),
+			},
+			{
+				Config: testAccUserConfig_nameFromDisplayName(rName, "Jane Q. Public"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckUserExists(ctx, resourceName, &user),
-					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Jane Q. Public"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.family_name", "Public"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.given_name", "Jane Q."),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_from_display_name"},
+			},
 		},
 	})
 }
@@ -926,6 +1705,84 @@ func TestAccIdentityStoreUser_Title(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreUser_unsetAttributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user identitystore.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_titleTimezone(rName, "Mr", "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Mr"),
+				),
+			},
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					testAccCheckUserAttributesUnset(&user),
+					resource.TestCheckResourceAttr(resourceName, "timezone", ""),
+					resource.TestCheckResourceAttr(resourceName, "title", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_UserName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var user1, user2 identitystore.DescribeUserOutput
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_identitystore_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user1),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName1),
+				),
+			},
+			{
+				Config: testAccUserConfig_basic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user2),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName2),
+					testAccCheckUserNotRecreated(&user1, &user2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIdentityStoreUser_UserType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user identitystore.DescribeUserOutput
@@ -1027,6 +1884,96 @@ func testAccCheckUserExists(ctx context.Context, n string, v *identitystore.Desc
 	}
 }
 
+func testAccUserImportStateIdFuncByUserName(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["user_name"]), nil
+	}
+}
+
+func testAccCheckUserNotRecreated(before, after *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.UserId), aws.ToString(after.UserId); before != after {
+			return fmt.Errorf("IdentityStore User (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckUserAttributesUnset checks that attributes removed from the
+// configuration were removed from the user, not set to empty strings.
+func testAccCheckUserAttributesUnset(v *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.Timezone != nil {
+			return fmt.Errorf("IdentityStore User (%s) Timezone = %q, expected unset", aws.ToString(v.UserId), aws.ToString(v.Timezone))
+		}
+
+		if v.Title != nil {
+			return fmt.Errorf("IdentityStore User (%s) Title = %q, expected unset", aws.ToString(v.UserId), aws.ToString(v.Title))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserRecreated(before, after *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.UserId), aws.ToString(after.UserId); before == after {
+			return fmt.Errorf("IdentityStore User (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckUserRecreate deletes the user and creates another with the same
+// attributes outside Terraform.
+func testAccCheckUserRecreate(ctx context.Context, v *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		if err := tfidentitystore.DeleteUser(ctx, conn, &identitystore.DeleteUserInput{
+			IdentityStoreId: v.IdentityStoreId,
+			UserId:          v.UserId,
+		}, 2*time.Minute); err != nil {
+			return err
+		}
+
+		_, err := tfidentitystore.CreateUser(ctx, conn, &identitystore.CreateUserInput{
+			DisplayName:     v.DisplayName,
+			IdentityStoreId: v.IdentityStoreId,
+			Name:            v.Name,
+			UserName:        v.UserName,
+		}, 2*time.Minute)
+
+		return err
+	}
+}
+
+func testAccUserConfig_recreateMissing(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name     = "Acceptance Test"
+  recreate_missing = true
+  user_name        = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+`, rName)
+}
+
 func testAccUserConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -1045,6 +1992,36 @@ resource "aws_identitystore_user" "test" {
 `, rName)
 }
 
+func testAccCheckUserNoAddresses(user *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if n := len(user.Addresses); n != 0 {
+			return fmt.Errorf("expected no addresses, got %d", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccUserConfig_addressesEmpty(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+
+  addresses {}
+}
+`, rName)
+}
+
 func testAccUserConfig_addresses1(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -1194,6 +2171,100 @@ resource "aws_identitystore_user" "test" {
 `, rName, email)
 }
 
+func testAccUserConfig_emailsMultiple(rName, email1, email2 string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "John"
+    given_name  = "Doe"
+  }
+
+  emails {
+    primary = true
+    type    = "work"
+    value   = %[2]q
+  }
+
+  emails {
+    primary = false
+    type    = "home"
+    value   = %[3]q
+  }
+}
+`, rName, email1, email2)
+}
+
+func testAccUserConfig_ignoreContactOrder(rName, email1, email2 string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "John"
+    given_name  = "Doe"
+  }
+
+  ignore_contact_order = true
+
+  emails {
+    primary = false
+    type    = "work"
+    value   = %[2]q
+  }
+
+  emails {
+    primary = true
+    type    = "home"
+    value   = %[3]q
+  }
+}
+`, rName, email1, email2)
+}
+
+func testAccUserConfig_groupMemberships(rName string, includeGroupMemberships bool) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "John"
+    given_name  = "Doe"
+  }
+
+  include_group_memberships = %[2]t
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+}
+
+resource "aws_identitystore_group_membership" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id  = aws_identitystore_group.test.group_id
+  member_id = aws_identitystore_user.test.user_id
+}
+`, rName, includeGroupMemberships)
+}
+
 func testAccUserConfig_locale(rName, locale string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -1326,6 +2397,24 @@ resource "aws_identitystore_user" "test" {
 `, rName, middleName)
 }
 
+func testAccUserConfig_nameFromDisplayName(rName, displayName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name           = %[2]q
+  name_from_display_name = true
+  user_name              = %[1]q
+
+  emails {
+    value = "%[1]s@example.com"
+  }
+}
+`, rName, displayName)
+}
+
 func testAccUserConfig_nickName(rName, nickName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -1496,6 +2585,27 @@ resource "aws_identitystore_user" "test" {
 `, rName, title)
 }
 
+func testAccUserConfig_titleTimezone(rName, title, timezone string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  timezone = %[3]q
+  title    = %[2]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+`, rName, title, timezone)
+}
+
 func testAccUserConfig_userType(rName, userType string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userUpdateField maps an attribute of the aws_identitystore_user resource to
+// the attribute path used by the UpdateUser API.
+type userUpdateField struct {
+	// Attribute corresponds to the provider schema.
+	Attribute string
+
+	// Field corresponds to the AWS API schema. Attribute paths are
+	// case-sensitive and an unrecognized path is silently ignored by the
+	// API, so these must exactly match the lower camel case field names of
+	// types.User.
+	Field string
+
+	// Expand, when not nil, is used to transform the value of the field
+	// given in Attribute before it's passed to the UpdateOperation.
+	Expand func(interface{}) interface{}
+}
+
+// IMPLEMENTATION NOTE.
+//
+// Complex types, such as the `emails` field, don't allow field by field
+// updates, and require that the entire sub-object is modified.
+//
+// In those sub-objects, to remove a field, it must not be present at all
+// in the updated attribute value.
+//
+// However, structs such as types.Email don't specify omitempty in their
+// struct tags, so the document.NewLazyDocument marshaller will write out
+// nulls.
+//
+// This is why, for those complex fields, a custom Expand function is
+// provided that converts the Go SDK type (e.g. types.Email) into a field
+// by field representation of what the API would expect.
+var userUpdateFields = []userUpdateField{
+	{
+		Attribute: "display_name",
+		Field:     "displayName",
+	},
+	{
+		Attribute: "locale",
+		Field:     "locale",
+	},
+	{
+		Attribute: "name.0.family_name",
+		Field:     "name.familyName",
+	},
+	{
+		Attribute: "name.0.formatted",
+		Field:     "name.formatted",
+	},
+	{
+		Attribute: "name.0.given_name",
+		Field:     "name.givenName",
+	},
+	{
+		Attribute: "name.0.honorific_prefix",
+		Field:     "name.honorificPrefix",
+	},
+	{
+		Attribute: "name.0.honorific_suffix",
+		Field:     "name.honorificSuffix",
+	},
+	{
+		Attribute: "name.0.middle_name",
+		Field:     "name.middleName",
+	},
+	{
+		Attribute: "nickname",
+		Field:     "nickName",
+	},
+	{
+		Attribute: "preferred_language",
+		Field:     "preferredLanguage",
+	},
+	{
+		Attribute: "profile_url",
+		Field:     "profileUrl",
+	},
+	{
+		Attribute: "timezone",
+		Field:     "timezone",
+	},
+	{
+		Attribute: "title",
+		Field:     "title",
+	},
+	{
+		Attribute: "user_name",
+		Field:     "userName",
+	},
+	{
+		Attribute: "user_type",
+		Field:     "userType",
+	},
+	{
+		Attribute: "addresses",
+		Field:     "addresses",
+		Expand: func(value interface{}) interface{} {
+			addresses := expandAddresses(value.([]interface{}))
+
+			var result []interface{}
+
+			// The API requires a null to unset the list, so in the case
+			// of no addresses, a nil result is preferable.
+			for _, address := range addresses {
+				m := map[string]interface{}{}
+
+				if v := address.Country; v != nil {
+					m["country"] = v
+				}
+
+				if v := address.Formatted; v != nil {
+					m["formatted"] = v
+				}
+
+				if v := address.Locality; v != nil {
+					m["locality"] = v
+				}
+
+				if v := address.PostalCode; v != nil {
+					m["postalCode"] = v
+				}
+
+				m["primary"] = address.Primary
+
+				if v := address.Region; v != nil {
+					m["region"] = v
+				}
+
+				if v := address.StreetAddress; v != nil {
+					m["streetAddress"] = v
+				}
+
+				if v := address.Type; v != nil {
+					m["type"] = v
+				}
+
+				result = append(result, m)
+			}
+
+			return result
+		},
+	},
+	{
+		Attribute: "emails",
+		Field:     "emails",
+		Expand: func(value interface{}) interface{} {
+			emails := expandEmails(value.([]interface{}))
+
+			var result []interface{}
+
+			// The API requires a null to unset the list, so in the case
+			// of no emails, a nil result is preferable.
+			for _, email := range emails {
+				m := map[string]interface{}{}
+
+				m["primary"] = email.Primary
+
+				if v := email.Type; v != nil {
+					m["type"] = v
+				}
+
+				if v := email.Value; v != nil {
+					m["value"] = v
+				}
+
+				result = append(result, m)
+			}
+
+			return result
+		},
+	},
+	{
+		Attribute: "phone_numbers",
+		Field:     "phoneNumbers",
+		Expand: func(value interface{}) interface{} {
+			phoneNumbers := expandPhoneNumbers(value.([]interface{}))
+
+			var result []interface{}
+
+			// The API requires a null to unset the list, so in the case
+			// of no phone numbers, a nil result is preferable.
+			for _, phoneNumber := range phoneNumbers {
+				m := map[string]interface{}{}
+
+				m["primary"] = phoneNumber.Primary
+
+				if v := phoneNumber.Type; v != nil {
+					m["type"] = v
+				}
+
+				if v := phoneNumber.Value; v != nil {
+					m["value"] = v
+				}
+
+				result = append(result, m)
+			}
+
+			return result
+		},
+	},
+}
+
+// buildUpdateOperations returns an UpdateUser operation for each of the given
+// fields whose attribute has changed, in the order of fields.
+func buildUpdateOperations(d *schema.ResourceData, fields []userUpdateField) []types.AttributeOperation {
+	var operations []types.AttributeOperation
+
+	for _, field := range fields {
+		if !d.HasChange(field.Attribute) {
+			continue
+		}
+
+		value := d.Get(field.Attribute)
+
+		if expand := field.Expand; expand != nil {
+			value = expand(value)
+		}
+
+		// The API doesn't allow empty attribute values. To unset an
+		// attribute, set it to null.
+		if value == "" {
+			value = nil
+		}
+
+		operations = append(operations, types.AttributeOperation{
+			AttributePath:  aws.String(field.Field),
+			AttributeValue: document.NewLazyDocument(value),
+		})
+	}
+
+	return operations
+}
+
+// updateOperationsError annotates an UpdateUser error with the attributes that
+// were being updated. UpdateUser fails as a whole when any operation is
+// rejected, often without saying which one.
+func updateOperationsError(operations []types.AttributeOperation, fields []userUpdateField, err error) error {
+	return fmt.Errorf("attributes %s: %w", strings.Join(updateOperationAttributes(operations, fields), ", "), err)
+}
+
+// updateOperationAttributes returns the attribute of fields that corresponds
+// to each operation's attribute path, or the path itself if there's none.
+func updateOperationAttributes(operations []types.AttributeOperation, fields []userUpdateField) []string {
+	attributes := make([]string, 0, len(operations))
+
+	for _, operation := range operations {
+		path := aws.ToString(operation.AttributePath)
+		attribute := path
+
+		for _, field := range fields {
+			if field.Field == path {
+				attribute = field.Attribute
+				break
+			}
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+// userNameConfigured returns whether the value of the name attribute holds a
+// name block.
+func userNameConfigured(v interface{}) bool {
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 {
+		return false
+	}
+
+	_, ok = tfList[0].(map[string]interface{})
+
+	return ok
+}
+
+// rawUserNameConfigured returns whether the raw config value of the name
+// attribute holds a name block. An unknown value, e.g. from a dynamic block
+// whose for_each isn't known yet, is treated as configured.
+func rawUserNameConfigured(v cty.Value) bool {
+	if !v.IsKnown() {
+		return true
+	}
+
+	return !v.IsNull() && v.LengthInt() > 0
+}
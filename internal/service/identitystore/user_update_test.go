@@ -0,0 +1,881 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestUserUpdateFieldsAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	// Attribute paths as documented for the UpdateUser API.
+	expected := map[string]string{
+		"addresses":               "addresses",
+		"display_name":            "displayName",
+		"emails":                  "emails",
+		"locale":                  "locale",
+		"name.0.family_name":      "name.familyName",
+		"name.0.formatted":        "name.formatted",
+		"name.0.given_name":       "name.givenName",
+		"name.0.honorific_prefix": "name.honorificPrefix",
+		"name.0.honorific_suffix": "name.honorificSuffix",
+		"name.0.middle_name":      "name.middleName",
+		"nickname":                "nickName",
+		"phone_numbers":           "phoneNumbers",
+		"preferred_language":      "preferredLanguage",
+		"profile_url":             "profileUrl",
+		"timezone":                "timezone",
+		"title":                   "title",
+		"user_name":               "userName",
+		"user_type":               "userType",
+	}
+
+	if got, want := len(userUpdateFields), len(expected); got != want {
+		t.Errorf("got %d update fields, expected %d", got, want)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, field := range userUpdateFields {
+		field := field
+
+		t.Run(field.Attribute, func(t *testing.T) {
+			t.Parallel()
+
+			if want, ok := expected[field.Attribute]; !ok {
+				t.Errorf("unexpected attribute %q", field.Attribute)
+			} else if field.Field != want {
+				t.Errorf("attribute %q maps to path %q, expected %q", field.Attribute, field.Field, want)
+			}
+
+			if err := resolveAttributePath(reflect.TypeOf(types.User{}), field.Field); err != nil {
+				t.Errorf("attribute %q maps to path %q: %s", field.Attribute, field.Field, err)
+			}
+		})
+
+		if seen[field.Field] {
+			t.Errorf("duplicate attribute path %q", field.Field)
+		}
+		seen[field.Field] = true
+	}
+}
+
+func TestUserUpdateFieldsCoverSchema(t *testing.T) {
+	t.Parallel()
+
+	// Any attribute that can change without replacing the user must be in
+	// userUpdateFields, otherwise resourceUserUpdate silently ignores it.
+	attributes := make(map[string]bool)
+	for _, field := range userUpdateFields {
+		attributes[field.Attribute] = true
+	}
+
+	for _, k := range userProviderOnlyAttributes {
+		attributes[k] = true
+	}
+
+	updatable := func(s *schema.Schema) bool {
+		return !s.ForceNew && (s.Optional || s.Required)
+	}
+
+	for k, s := range ResourceUser().Schema {
+		if !updatable(s) || attributes[k] {
+			continue
+		}
+
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList || s.MaxItems != 1 {
+			t.Errorf("updatable attribute %q is not in userUpdateFields", k)
+			continue
+		}
+
+		for nk, ns := range elem.Schema {
+			if path := fmt.Sprintf("%s.0.%s", k, nk); updatable(ns) && !attributes[path] {
+				t.Errorf("updatable attribute %q is not in userUpdateFields", path)
+			}
+		}
+	}
+}
+
+func TestUpdateOperationsError(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &types.ValidationException{Message: aws.String("Invalid request")}
+
+	testCases := map[string]struct {
+		operations []types.AttributeOperation
+		expected   string
+	}{
+		"attribute": {
+			operations: []types.AttributeOperation{
+				{AttributePath: aws.String("title"), AttributeValue: document.NewLazyDocument("Engineer")},
+			},
+			expected: "attributes title: ",
+		},
+		"nested attribute": {
+			operations: []types.AttributeOperation{
+				{AttributePath: aws.String("name.givenName"), AttributeValue: document.NewLazyDocument("John")},
+			},
+			expected: "attributes name.0.given_name: ",
+		},
+		"unknown path": {
+			operations: []types.AttributeOperation{
+				{AttributePath: aws.String("unknownPath"), AttributeValue: document.NewLazyDocument("x")},
+			},
+			expected: "attributes unknownPath: ",
+		},
+		"multiple": {
+			operations: []types.AttributeOperation{
+				{AttributePath: aws.String("title"), AttributeValue: document.NewLazyDocument("Engineer")},
+				{AttributePath: aws.String("name.givenName"), AttributeValue: document.NewLazyDocument("John")},
+				{AttributePath: aws.String("unknownPath"), AttributeValue: document.NewLazyDocument("x")},
+			},
+			expected: "attributes title, name.0.given_name, unknownPath: ",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := updateOperationsError(testCase.operations, userUpdateFields, apiErr)
+
+			if got, want := err.Error(), testCase.expected+apiErr.Error(); got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+
+			if !errs.IsA[*types.ValidationException](err) {
+				t.Errorf("expected wrapped ValidationException, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserImportThenAddEmail(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := ResourceUser()
+
+	// Import a user without contact information.
+	d := r.TestResourceData()
+	d.SetId("d-1234567890/00000000-0000-0000-0000-000000000000")
+
+	if _, err := resourceUserImport(ctx, d, nil); err != nil {
+		t.Fatalf("importing: %s", err)
+	}
+
+	d.Set("display_name", "Acceptance Test")
+	d.Set("identity_store_id", "d-1234567890")
+	d.Set("name", []interface{}{flattenName(&types.Name{FamilyName: aws.String("Doe"), GivenName: aws.String("John")})})
+	d.Set("user_id", "00000000-0000-0000-0000-000000000000")
+	d.Set("user_name", "jdoe")
+	d.Set("addresses", flattenAddresses(nil))
+	d.Set("emails", flattenEmails(nil))
+	d.Set("external_ids", flattenExternalIds(nil))
+	d.Set("group_memberships", nil)
+	d.Set("phone_numbers", flattenPhoneNumbers(nil))
+
+	for _, k := range userProviderOnlyAttributes {
+		if got := d.State().Attributes[k]; got != "false" {
+			t.Errorf("imported %s = %q, expected %q", k, got, "false")
+		}
+	}
+
+	// Then add an email to the configuration.
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name":      "Acceptance Test",
+		"identity_store_id": "d-1234567890",
+		"name": []interface{}{
+			map[string]interface{}{
+				"family_name": "Doe",
+				"given_name":  "John",
+			},
+		},
+		"user_name": "jdoe",
+		"emails": []interface{}{
+			map[string]interface{}{
+				"primary": true,
+				"value":   "jdoe@example.com",
+			},
+		},
+	})
+
+	diff, err := r.Diff(ctx, d.State(), config, nil)
+	if err != nil {
+		t.Fatalf("diffing: %s", err)
+	}
+
+	if diff == nil || len(diff.Attributes) == 0 {
+		t.Fatal("expected a diff adding the email")
+	}
+
+	if diff.RequiresNew() {
+		t.Error("expected an in-place update")
+	}
+
+	for k := range diff.Attributes {
+		if !strings.HasPrefix(k, "emails.") {
+			t.Errorf("unexpected change to %s", k)
+		}
+	}
+}
+
+func TestDefaultUserDisplayName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     map[string]interface{}
+		expected string
+	}{
+		"given and family": {
+			name:     map[string]interface{}{"family_name": "Doe", "given_name": "John"},
+			expected: "John Doe",
+		},
+		"formatted": {
+			name:     map[string]interface{}{"family_name": "Doe", "formatted": "Dr. John Q. Doe", "given_name": "John"},
+			expected: "Dr. John Q. Doe",
+		},
+		"empty formatted": {
+			name:     map[string]interface{}{"family_name": "Doe", "formatted": "", "given_name": "John"},
+			expected: "John Doe",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := defaultUserDisplayName(testCase.name); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestUserCustomizeDiffDisplayName(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := ResourceUser()
+
+	testCases := map[string]struct {
+		config      map[string]interface{}
+		expected    string
+		expectError bool
+	}{
+		"configured": {
+			config:   map[string]interface{}{"display_name": "Johnny"},
+			expected: "Johnny",
+		},
+		"configured with display_name_from_name": {
+			config:   map[string]interface{}{"display_name": "Johnny", "display_name_from_name": true},
+			expected: "Johnny",
+		},
+		"derived": {
+			config:   map[string]interface{}{"display_name_from_name": true},
+			expected: "John Doe",
+		},
+		"missing": {
+			config:      map[string]interface{}{},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{
+				"identity_store_id": "d-1234567890",
+				"name": []interface{}{
+					map[string]interface{}{
+						"family_name": "Doe",
+						"given_name":  "John",
+					},
+				},
+				"user_name": "jdoe",
+			}
+			for k, v := range testCase.config {
+				raw[k] = v
+			}
+
+			diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), nil)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("diffing: %s", err)
+			}
+
+			attr, ok := diff.Attributes["display_name"]
+			if !ok {
+				t.Fatal("expected a diff for display_name")
+			}
+
+			if attr.New != testCase.expected {
+				t.Errorf("display_name = %q, expected %q", attr.New, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDefaultUserName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		displayName        string
+		expectedGivenName  string
+		expectedFamilyName string
+	}{
+		"two words": {
+			displayName:        "John Doe",
+			expectedGivenName:  "John",
+			expectedFamilyName: "Doe",
+		},
+		"several words": {
+			displayName:        "Mary Ann  van Doe",
+			expectedGivenName:  "Mary Ann van",
+			expectedFamilyName: "Doe",
+		},
+		"single word": {
+			displayName:        "jdoe",
+			expectedGivenName:  "jdoe",
+			expectedFamilyName: "jdoe",
+		},
+		"empty": {},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfMap := defaultUserName(testCase.displayName)
+
+			if got := tfMap["given_name"]; got != testCase.expectedGivenName {
+				t.Errorf("given_name = %q, expected %q", got, testCase.expectedGivenName)
+			}
+
+			if got := tfMap["family_name"]; got != testCase.expectedFamilyName {
+				t.Errorf("family_name = %q, expected %q", got, testCase.expectedFamilyName)
+			}
+		})
+	}
+}
+
+func TestUserCustomizeDiffName(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := ResourceUser()
+	name := []interface{}{
+		map[string]interface{}{
+			"family_name": "Doe",
+			"given_name":  "John",
+		},
+	}
+
+	testCases := map[string]struct {
+		config             map[string]interface{}
+		expectedGivenName  string
+		expectedFamilyName string
+		expectError        bool
+	}{
+		"configured": {
+			config:             map[string]interface{}{"name": name},
+			expectedGivenName:  "John",
+			expectedFamilyName: "Doe",
+		},
+		"configured with name_from_display_name": {
+			config:             map[string]interface{}{"name": name, "name_from_display_name": true},
+			expectedGivenName:  "John",
+			expectedFamilyName: "Doe",
+		},
+		"derived": {
+			config:             map[string]interface{}{"name_from_display_name": true},
+			expectedGivenName:  "Jane Q.",
+			expectedFamilyName: "Public",
+		},
+		"missing": {
+			config:      map[string]interface{}{},
+			expectError: true,
+		},
+		"conflicting flags": {
+			config:      map[string]interface{}{"display_name_from_name": true, "name_from_display_name": true},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{
+				"display_name":      "Jane Q. Public",
+				"emails":            []interface{}{map[string]interface{}{"value": "jpublic@example.com"}},
+				"identity_store_id": "d-1234567890",
+				"user_name":         "jpublic",
+			}
+			for k, v := range testCase.config {
+				raw[k] = v
+			}
+
+			config := terraform.NewResourceConfigRaw(raw)
+
+			if diags := r.Validate(config); diags.HasError() {
+				if !testCase.expectError {
+					t.Fatalf("validating: %v", diags)
+				}
+
+				return
+			}
+
+			diff, err := r.Diff(ctx, nil, config, nil)
+
+			if testCase.expectError {
+				if err == nil || !strings.Contains(err.Error(), `"name" is required unless "name_from_display_name" is true`) {
+					t.Fatalf("expected name required error, got: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("diffing: %s", err)
+			}
+
+			for k, expected := range map[string]string{
+				"name.0.given_name":  testCase.expectedGivenName,
+				"name.0.family_name": testCase.expectedFamilyName,
+			} {
+				attr, ok := diff.Attributes[k]
+				if !ok {
+					t.Fatalf("expected a diff for %s", k)
+				}
+
+				if attr.New != expected {
+					t.Errorf("%s = %q, expected %q", k, attr.New, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUserNameConfigured(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    interface{}
+		expected bool
+	}{
+		"nil": {
+			value:    nil,
+			expected: false,
+		},
+		"empty list": {
+			value:    []interface{}{},
+			expected: false,
+		},
+		"nil block": {
+			value:    []interface{}{nil},
+			expected: false,
+		},
+		"name block": {
+			value: []interface{}{
+				map[string]interface{}{
+					"family_name": "Doe",
+					"given_name":  "John",
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := userNameConfigured(testCase.value); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+
+	t.Run("missing name block", func(t *testing.T) {
+		t.Parallel()
+
+		d := ResourceUser().TestResourceData()
+
+		if userNameConfigured(d.Get("name")) {
+			t.Error("expected missing name block to not be configured")
+		}
+
+		for _, field := range userUpdateFields {
+			if strings.HasPrefix(field.Attribute, "name.") {
+				if got := d.Get(field.Attribute); got != "" {
+					t.Errorf("%s = %#v, expected empty string", field.Attribute, got)
+				}
+			}
+		}
+	})
+}
+
+func TestRawUserNameConfigured(t *testing.T) {
+	t.Parallel()
+
+	nameType := cty.List(cty.Object(map[string]cty.Type{
+		"family_name": cty.String,
+		"given_name":  cty.String,
+	}))
+
+	testCases := map[string]struct {
+		value    cty.Value
+		expected bool
+	}{
+		"null": {
+			value:    cty.NullVal(nameType),
+			expected: false,
+		},
+		"empty list": {
+			value:    cty.ListValEmpty(nameType.ElementType()),
+			expected: false,
+		},
+		"unknown": {
+			value:    cty.UnknownVal(nameType),
+			expected: true,
+		},
+		"name block": {
+			value: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"family_name": cty.StringVal("Doe"),
+					"given_name":  cty.StringVal("John"),
+				}),
+			}),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := rawUserNameConfigured(testCase.value); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestUserUpdateFieldsExpandMultiple(t *testing.T) {
+	t.Parallel()
+
+	// Each element of a list attribute must become its own entry in the
+	// UpdateOperation value, in configuration order.
+	testCases := map[string][]interface{}{
+		"addresses": {
+			map[string]interface{}{"primary": true, "type": "work", "locality": "Seattle"},
+			map[string]interface{}{"primary": false, "type": "home", "locality": "Portland"},
+		},
+		"emails": {
+			map[string]interface{}{"primary": true, "type": "work", "value": "jdoe@example.com"},
+			map[string]interface{}{"primary": false, "type": "home", "value": "john.doe@example.org"},
+		},
+		"phone_numbers": {
+			map[string]interface{}{"primary": true, "type": "work", "value": "+1 206 555 0100"},
+			map[string]interface{}{"primary": false, "type": "home", "value": "+1 503 555 0100"},
+		},
+	}
+
+	for _, field := range userUpdateFields {
+		field := field
+
+		tfList, ok := testCases[field.Attribute]
+		if !ok {
+			continue
+		}
+
+		t.Run(field.Attribute, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := field.Expand(tfList).([]interface{})
+			if !ok {
+				t.Fatalf("expected a list, got %T", field.Expand(tfList))
+			}
+
+			if len(got) != len(tfList) {
+				t.Fatalf("got %d entries, expected %d", len(got), len(tfList))
+			}
+
+			for i, v := range got {
+				m := v.(map[string]interface{})
+				want := tfList[i].(map[string]interface{})
+
+				if m["primary"] != want["primary"] {
+					t.Errorf("entry %d: primary = %#v, expected %#v", i, m["primary"], want["primary"])
+				}
+
+				if got, want := aws.ToString(m["type"].(*string)), want["type"]; got != want {
+					t.Errorf("entry %d: type = %q, expected %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// resolveAttributePath walks the dot-separated API attribute path through the
+// given SDK type, matching each segment case-sensitively against the
+// lower camel case form of the exported field names.
+func resolveAttributePath(typ reflect.Type, path string) error {
+	for _, segment := range strings.Split(path, ".") {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			return fmt.Errorf("cannot resolve segment %q in non-struct type %s", segment, typ)
+		}
+
+		var found bool
+
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+
+			if !f.IsExported() {
+				continue
+			}
+
+			name := []rune(f.Name)
+			name[0] = unicode.ToLower(name[0])
+
+			if string(name) == segment {
+				typ = f.Type
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("no field matching segment %q in %s", segment, typ)
+		}
+	}
+
+	return nil
+}
+
+func TestBuildUpdateOperations(t *testing.T) {
+	t.Parallel()
+
+	// The user in state, which each test case's configuration changes.
+	state := &terraform.InstanceState{
+		ID: "d-1234567890/00000000-0000-0000-0000-000000000000",
+		Attributes: map[string]string{
+			"display_name":                  "Acceptance Test",
+			"identity_store_id":             "d-1234567890",
+			"ignore_contact_order":          "false",
+			"implicit_primary_phone_number": "false",
+			"include_group_memberships":     "false",
+			"name.#":                        "1",
+			"name.0.family_name":            "Doe",
+			"name.0.given_name":             "John",
+			"nickname":                      "jd",
+			"user_id":                       "00000000-0000-0000-0000-000000000000",
+			"user_name":                     "jdoe",
+		},
+	}
+	config := func(changes map[string]interface{}) map[string]interface{} {
+		m := map[string]interface{}{
+			"display_name":      "Acceptance Test",
+			"identity_store_id": "d-1234567890",
+			"name": []interface{}{
+				map[string]interface{}{
+					"family_name": "Doe",
+					"given_name":  "John",
+				},
+			},
+			"nickname":  "jd",
+			"user_name": "jdoe",
+		}
+
+		for k, v := range changes {
+			if v == nil {
+				delete(m, k)
+			} else {
+				m[k] = v
+			}
+		}
+
+		return m
+	}
+
+	type operation struct {
+		path  string
+		value string
+	}
+
+	testCases := map[string]struct {
+		fields   []userUpdateField
+		changes  map[string]interface{}
+		expected []operation
+	}{
+		"no changes": {
+			fields: userUpdateFields,
+		},
+		"string": {
+			fields: userUpdateFields,
+			changes: map[string]interface{}{
+				"display_name": "Updated",
+			},
+			expected: []operation{
+				{path: "displayName", value: `"Updated"`},
+			},
+		},
+		"unset": {
+			fields: userUpdateFields,
+			changes: map[string]interface{}{
+				"nickname": nil,
+			},
+			expected: []operation{
+				{path: "nickName", value: `null`},
+			},
+		},
+		"nested": {
+			fields: userUpdateFields,
+			changes: map[string]interface{}{
+				"name": []interface{}{
+					map[string]interface{}{
+						"family_name": "Roe",
+						"given_name":  "Jane",
+					},
+				},
+			},
+			expected: []operation{
+				{path: "name.familyName", value: `"Roe"`},
+				{path: "name.givenName", value: `"Jane"`},
+			},
+		},
+		"expanded": {
+			fields: userUpdateFields,
+			changes: map[string]interface{}{
+				"emails": []interface{}{
+					map[string]interface{}{
+						"primary": true,
+						"value":   "jdoe@example.com",
+					},
+				},
+			},
+			expected: []operation{
+				{path: "emails", value: `[{"primary":true,"value":"jdoe@example.com"}]`},
+			},
+		},
+		"field order": {
+			fields: userUpdateFields,
+			changes: map[string]interface{}{
+				"title":        "Engineer",
+				"display_name": "Updated",
+			},
+			expected: []operation{
+				{path: "displayName", value: `"Updated"`},
+				{path: "title", value: `"Engineer"`},
+			},
+		},
+		"only given fields": {
+			fields: []userUpdateField{
+				{Attribute: "title", Field: "title"},
+			},
+			changes: map[string]interface{}{
+				"title":        "Engineer",
+				"display_name": "Updated",
+			},
+			expected: []operation{
+				{path: "title", value: `"Engineer"`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := ResourceUser()
+
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(testCase.changes)), nil)
+			if err != nil {
+				t.Fatalf("diffing: %s", err)
+			}
+
+			d, err := schema.InternalMap(r.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatalf("reading diff: %s", err)
+			}
+
+			got := buildUpdateOperations(d, testCase.fields)
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("got %d operations, expected %d", len(got), len(testCase.expected))
+			}
+
+			for i, want := range testCase.expected {
+				if got := aws.ToString(got[i].AttributePath); got != want.path {
+					t.Errorf("operation %d: path = %q, expected %q", i, got, want.path)
+				}
+
+				b, err := got[i].AttributeValue.MarshalSmithyDocument()
+				if err != nil {
+					t.Fatalf("operation %d: marshaling value: %s", i, err)
+				}
+
+				// Compare decoded values, as object keys are in no particular order.
+				var gotValue, wantValue interface{}
+				if err := json.Unmarshal(b, &gotValue); err != nil {
+					t.Fatalf("operation %d: decoding value: %s", i, err)
+				}
+				if err := json.Unmarshal([]byte(want.value), &wantValue); err != nil {
+					t.Fatalf("operation %d: decoding expected value: %s", i, err)
+				}
+
+				if !reflect.DeepEqual(gotValue, wantValue) {
+					t.Errorf("operation %d: value = %s, expected %s", i, b, want.value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// validIdentityStoreID validates the documented IdentityStoreId pattern: a
+// d- prefixed ID, or a UUID.
+var validIdentityStoreID schema.SchemaValidateDiagFunc = validation.ToDiagFunc(validation.StringMatch(
+	regexache.MustCompile(`^(d-[0-9a-f]{10}|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`),
+	"must be an identity store ID, e.g. d-1234567890",
+))
+
+// validDisplayName validates the documented DisplayName length and rejects
+// values that are entirely whitespace, which the API may reject or store as-is.
+var validDisplayName schema.SchemaValidateDiagFunc = validation.ToDiagFunc(validation.All(
+	validation.StringLenBetween(1, 1024),
+	validation.StringMatch(regexache.MustCompile(`\S`), "must contain at least one non-whitespace character"),
+))
+
+// validTimezone warns, without failing validation, when the value isn't an IANA
+// time zone name such as "America/New_York". Abbreviations such as "EST" are
+// accepted by the API but are ambiguous, so they're also warned about.
+func validTimezone(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return diags
+	}
+
+	// The time zone database isn't available on every platform.
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		return diags
+	}
+
+	switch value {
+	case "UTC", "Etc/UTC":
+		return diags
+	}
+
+	if _, err := time.LoadLocation(value); err != nil || value == "Local" || !strings.Contains(value, "/") {
+		diags = append(diags, errs.NewAttributeWarningDiagnostic(path,
+			"Unrecognized time zone",
+			fmt.Sprintf("%q is not an IANA time zone name in Area/Location form, e.g. \"America/New_York\".", value),
+		))
+	}
+
+	return diags
+}
+
+// validEmailAddress warns, without failing validation, when the value doesn't
+// look like an email address. Only the overall shape is checked, since the
+// API's own validation is more permissive than RFC 5322.
+func validEmailAddress(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return diags
+	}
+
+	if !regexache.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`).MatchString(value) {
+		diags = append(diags, errs.NewAttributeWarningDiagnostic(path,
+			"Unrecognized email address",
+			fmt.Sprintf("%q doesn't look like an email address, e.g. \"jdoe@example.com\".", value),
+		))
+	}
+
+	return diags
+}
+
+// validPhoneNumber warns, without failing validation, when the value doesn't
+// look like an E.164 phone number. Spaces, dots, dashes and parentheses
+// between digits are allowed.
+func validPhoneNumber(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return diags
+	}
+
+	digits := strings.NewReplacer(" ", "", ".", "", "-", "", "(", "", ")", "").Replace(value)
+
+	if !regexache.MustCompile(`^\+?[0-9]{7,15}$`).MatchString(digits) {
+		diags = append(diags, errs.NewAttributeWarningDiagnostic(path,
+			"Unrecognized phone number",
+			fmt.Sprintf("%q doesn't look like a phone number in E.164 form, e.g. \"+1 206 555 0100\".", value),
+		))
+	}
+
+	return diags
+}
+
+// validateSinglePrimary returns an error naming the offending blocks if more
+// than one entry in the list attribute k has primary = true. Identity Store
+// otherwise rejects the user at apply time with an opaque ValidationException.
+func validateSinglePrimary(k string, tfList []interface{}) error {
+	var primaries []string
+
+	for i, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if primary, ok := tfMap["primary"].(bool); ok && primary {
+				primaries = append(primaries, fmt.Sprintf("%s.%d", k, i))
+			}
+		}
+	}
+
+	if len(primaries) > 1 {
+		return fmt.Errorf("at most one %s block can have primary = true, found %d: %s", k, len(primaries), strings.Join(primaries, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidIdentityStoreID(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"d-1234567890",
+		"d-90679d1878",
+		"d-abcdef0123",
+		"00000000-0000-0000-0000-000000000000",
+		"94f1e788-8f61-4a16-a5cb-3ecc1d2bbb0e",
+	}
+	for _, v := range validValues {
+		if diags := validIdentityStoreID(v, cty.GetAttrPath("identity_store_id")); diags.HasError() {
+			t.Fatalf("%q should be a valid identity store ID: %v", v, diags)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"d-123456789",
+		"d-12345678901",
+		"d-ABCDEF0123",
+		"D-1234567890",
+		"1234567890",
+		"d_1234567890",
+		" d-1234567890",
+		"arn:aws:identitystore::123456789012:identitystore/d-1234567890", // lintignore:AWSAT005
+		"94f1e788-8f61-4a16-a5cb-3ecc1d2bbb0",
+	}
+	for _, v := range invalidValues {
+		if diags := validIdentityStoreID(v, cty.GetAttrPath("identity_store_id")); !diags.HasError() {
+			t.Fatalf("%q should be an invalid identity store ID", v)
+		}
+	}
+}
+
+func TestValidDisplayName(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"John Doe",
+		"x",
+		" padded ",
+		strings.Repeat("a", 1024),
+	}
+	for _, v := range validValues {
+		if diags := validDisplayName(v, cty.GetAttrPath("display_name")); diags.HasError() {
+			t.Fatalf("%q should be a valid display name: %v", v, diags)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		" ",
+		"   ",
+		"\t\n",
+		strings.Repeat("a", 1025),
+	}
+	for _, v := range invalidValues {
+		if diags := validDisplayName(v, cty.GetAttrPath("display_name")); !diags.HasError() {
+			t.Fatalf("%q should be an invalid display name", v)
+		}
+	}
+}
+
+func TestValidTimezone(t *testing.T) {
+	t.Parallel()
+
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	validValues := []string{
+		"",
+		"America/New_York",
+		"Europe/London",
+		"America/Argentina/Buenos_Aires",
+		"UTC",
+		"Etc/UTC",
+	}
+	for _, v := range validValues {
+		if diags := validTimezone(v, cty.GetAttrPath("timezone")); len(diags) != 0 {
+			t.Fatalf("%q should be a valid time zone: %v", v, diags)
+		}
+	}
+
+	unrecognizedValues := []string{
+		"EST",
+		"Local",
+		"America/New_Yrok",
+		"Eastern Standard Time",
+	}
+	for _, v := range unrecognizedValues {
+		diags := validTimezone(v, cty.GetAttrPath("timezone"))
+
+		if len(diags) != 1 {
+			t.Fatalf("%q should be an unrecognized time zone", v)
+		}
+
+		if diags[0].Severity != diag.Warning {
+			t.Fatalf("%q should produce a warning, got severity %v", v, diags[0].Severity)
+		}
+	}
+}
+
+func TestValidEmailAddress(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"",
+		"jdoe@example.com",
+		"john.doe+work@mail.example.co.uk",
+	}
+	for _, v := range validValues {
+		if diags := validEmailAddress(v, cty.GetAttrPath("value")); len(diags) != 0 {
+			t.Fatalf("%q should be a valid email address: %v", v, diags)
+		}
+	}
+
+	unrecognizedValues := []string{
+		"jdoe",
+		"jdoe@example",
+		"jdoe@@example.com",
+		"john doe@example.com",
+		"@example.com",
+	}
+	for _, v := range unrecognizedValues {
+		diags := validEmailAddress(v, cty.GetAttrPath("value"))
+
+		if len(diags) != 1 {
+			t.Fatalf("%q should be an unrecognized email address", v)
+		}
+
+		if diags[0].Severity != diag.Warning {
+			t.Fatalf("%q should produce a warning, got severity %v", v, diags[0].Severity)
+		}
+	}
+}
+
+func TestValidPhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"",
+		"+12065550100",
+		"+1 206 555 0100",
+		"+1 (206) 555-0100",
+		"206.555.0100",
+		"+442079460000",
+	}
+	for _, v := range validValues {
+		if diags := validPhoneNumber(v, cty.GetAttrPath("value")); len(diags) != 0 {
+			t.Fatalf("%q should be a valid phone number: %v", v, diags)
+		}
+	}
+
+	unrecognizedValues := []string{
+		"555-CALL",
+		"12345",
+		"+1 206 555 0100 ext 12",
+		"++12065550100",
+		"+1234567890123456",
+	}
+	for _, v := range unrecognizedValues {
+		diags := validPhoneNumber(v, cty.GetAttrPath("value"))
+
+		if len(diags) != 1 {
+			t.Fatalf("%q should be an unrecognized phone number", v)
+		}
+
+		if diags[0].Severity != diag.Warning {
+			t.Fatalf("%q should produce a warning, got severity %v", v, diags[0].Severity)
+		}
+	}
+}
+
+func TestUserDataSourceFilterAttributePath(t *testing.T) {
+	t.Parallel()
+
+	validate := DataSourceUser().Schema["filter"].Elem.(*schema.Resource).Schema["attribute_path"].ValidateFunc
+
+	for _, v := range []string{"UserName"} {
+		if _, errors := validate(v, "filter.0.attribute_path"); len(errors) != 0 {
+			t.Fatalf("%q should be a supported filter attribute path: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "username", "DisplayName", "Emails.Value", "Name.FamilyName"} {
+		if _, errors := validate(v, "filter.0.attribute_path"); len(errors) == 0 {
+			t.Fatalf("%q should be an unsupported filter attribute path", v)
+		}
+	}
+}
+
+func TestValidateSinglePrimary(t *testing.T) {
+	t.Parallel()
+
+	entry := func(primary bool) map[string]interface{} {
+		return map[string]interface{}{
+			"primary": primary,
+			"type":    "work",
+		}
+	}
+
+	testCases := map[string]struct {
+		tfList      []interface{}
+		expectError string
+	}{
+		"empty": {},
+		"zero primary": {
+			tfList: []interface{}{entry(false), entry(false)},
+		},
+		"one primary": {
+			tfList: []interface{}{entry(false), entry(true)},
+		},
+		"two primary": {
+			tfList:      []interface{}{entry(true), entry(false), entry(true)},
+			expectError: "at most one emails block can have primary = true, found 2: emails.0, emails.2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateSinglePrimary("emails", testCase.tfList)
+
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := err.Error(); got != testCase.expectError {
+				t.Errorf("got error %q, expected %q", got, testCase.expectError)
+			}
+		})
+	}
+}
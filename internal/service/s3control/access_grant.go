@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -115,6 +116,9 @@ func (r *accessGrantResource) Schema(ctx context.Context, request resource.Schem
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.RequiresReplace(),
 							},
+							Validators: []validator.String{
+								validS3SubPrefix(),
+							},
 						},
 					},
 				},
@@ -309,6 +313,61 @@ func (r *accessGrantResource) Delete(ctx context.Context, request resource.Delet
 	}
 }
 
+func (r *accessGrantResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data accessGrantResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	grantees, diags := data.Grantee.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, grantee := range grantees {
+		if grantee.GranteeType.IsUnknown() || grantee.GranteeType.IsNull() || grantee.GranteeIdentifier.IsUnknown() || grantee.GranteeIdentifier.IsNull() {
+			continue
+		}
+
+		if err := validateGranteeIdentifier(grantee.GranteeType.ValueEnum(), grantee.GranteeIdentifier.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("grantee").AtListIndex(i).AtName("grantee_identifier"),
+				"Invalid Attribute Configuration",
+				err.Error(),
+			)
+		}
+	}
+
+	if data.S3PrefixType.IsUnknown() || data.AccessGrantsLocationConfiguration.IsUnknown() {
+		return
+	}
+
+	configurations, diags := data.AccessGrantsLocationConfiguration.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var subPrefix string
+	if len(configurations) > 0 && configurations[0] != nil {
+		if configurations[0].S3SubPrefix.IsUnknown() {
+			return
+		}
+
+		subPrefix = configurations[0].S3SubPrefix.ValueString()
+	}
+
+	if err := validateAccessGrantS3SubPrefix(data.S3PrefixType.ValueEnum(), subPrefix); err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("access_grants_location_configuration"),
+			"Invalid Attribute Configuration",
+			err.Error(),
+		)
+	}
+}
+
 func (r *accessGrantResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
 }
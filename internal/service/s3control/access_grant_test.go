@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -151,6 +152,36 @@ func testAccAccessGrant_locationConfiguration(t *testing.T) {
 	})
 }
 
+func testAccAccessGrant_prefixLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_prefixLevel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.0.s3_sub_prefix", "prefix1/*"),
+					resource.TestMatchResourceAttr(resourceName, "grant_scope", regexache.MustCompile(`/prefix1/\*$`)),
+					resource.TestCheckNoResourceAttr(resourceName, "s3_prefix_type"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAccessGrantDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -192,6 +223,52 @@ func testAccCheckAccessGrantExists(ctx context.Context, n string) resource.TestC
 	}
 }
 
+func testAccAccessGrant_granteeIdentifierInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessGrantConfig_grantee("IAM", "94f1e788-8f61-4a16-a5cb-3ecc1d2bbb0e"),
+				ExpectError: regexache.MustCompile(`must be an IAM user or role ARN when grantee_type is IAM`),
+			},
+			{
+				Config:      testAccAccessGrantConfig_grantee("DIRECTORY_USER", "arn:aws:iam::123456789012:user/example"), // lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`must be an IAM Identity Center user or group ID \(UUID\) when grantee_type is DIRECTORY_USER`),
+			},
+			{
+				Config:      testAccAccessGrantConfig_grantee("DIRECTORY_GROUP", "example-group"),
+				ExpectError: regexache.MustCompile(`must be an IAM Identity Center user or group ID \(UUID\) when grantee_type is DIRECTORY_GROUP`),
+			},
+		},
+	})
+}
+
+func testAccAccessGrant_s3SubPrefixInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessGrantConfig_s3SubPrefix("prefix1/*", "Object"),
+				ExpectError: regexache.MustCompile(`must be an object key without wildcards when s3_prefix_type is Object`),
+			},
+			{
+				Config:      testAccAccessGrantConfig_s3SubPrefix("prefix1/**", ""),
+				ExpectError: regexache.MustCompile(`may only contain a single \* wildcard at the end`),
+			},
+		},
+	})
+}
+
 func testAccAccessGrantConfig_baseCustomLocation(rName string) string {
 	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_baseCustomLocation(rName), fmt.Sprintf(`
 resource "aws_iam_user" "test" {
@@ -221,6 +298,20 @@ resource "aws_s3control_access_grant" "test" {
 `)
 }
 
+func testAccAccessGrantConfig_grantee(granteeType, granteeIdentifier string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = "default"
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = %[1]q
+    grantee_identifier = %[2]q
+  }
+}
+`, granteeType, granteeIdentifier)
+}
+
 func testAccAccessGrantConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), fmt.Sprintf(`
 resource "aws_s3control_access_grant" "test" {
@@ -277,3 +368,43 @@ resource "aws_s3control_access_grant" "test" {
 }
 `)
 }
+
+func testAccAccessGrantConfig_prefixLevel(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_baseCustomLocation(rName), `
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+
+  access_grants_location_configuration {
+    s3_sub_prefix = "prefix1/*"
+  }
+}
+`)
+}
+
+func testAccAccessGrantConfig_s3SubPrefix(subPrefix, prefixType string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = "default"
+  permission                = "READ"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = "arn:${data.aws_partition.current.partition}:iam::123456789012:user/example"
+  }
+
+  access_grants_location_configuration {
+    s3_sub_prefix = %[1]q
+  }
+
+  s3_prefix_type = %[2]q != "" ? %[2]q : null
+}
+
+data "aws_partition" "current" {}
+`, subPrefix, prefixType)
+}
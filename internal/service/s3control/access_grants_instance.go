@@ -7,12 +7,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -70,6 +76,17 @@ func (r *accessGrantsInstanceResource) Schema(ctx context.Context, request resou
 					fwvalidators.AWSAccountID(),
 				},
 			},
+			"check_default_location": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"default_location_registered": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrID: framework.IDAttribute(),
 			"identity_center_application_arn": schema.StringAttribute{
 				Computed: true,
@@ -81,6 +98,15 @@ func (r *accessGrantsInstanceResource) Schema(ctx context.Context, request resou
 				CustomType: fwtypes.ARNType,
 				Optional:   true,
 			},
+			"identity_source": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -119,8 +145,22 @@ func (r *accessGrantsInstanceResource) Create(ctx context.Context, request resou
 	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
 	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
 	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+	data.IdentitySource = types.StringValue(accessGrantsInstanceIdentitySource(aws.ToString(output.IdentityCenterArn) != ""))
+	data.setRegion(r.Meta().Region)
 	data.setID()
 
+	if _, err := waitAccessGrantsInstanceCreated(ctx, conn, data.AccountID.ValueString(), propagationTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Access Grants Instance (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := data.refreshDefaultLocationRegistered(ctx, conn); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s) default location", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -160,6 +200,21 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
 	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
 	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+	data.IdentitySource = types.StringValue(accessGrantsInstanceIdentitySource(aws.ToString(output.IdentityCenterArn) != ""))
+	data.setRegion(r.Meta().Region)
+
+	// Only the Identity Center application's ARN is returned, not the Identity
+	// Center instance's, so only a dissociation made outside Terraform can be
+	// detected.
+	if aws.ToString(output.IdentityCenterArn) == "" {
+		data.IdentityCenterARN = fwtypes.ARNNull()
+	}
+
+	if err := data.refreshDefaultLocationRegistered(ctx, conn); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s) default location", data.ID.ValueString()), err.Error())
+
+		return
+	}
 
 	tags, err := listTags(ctx, conn, data.AccessGrantsInstanceARN.ValueString(), data.AccountID.ValueString())
 
@@ -207,6 +262,8 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 				return
 			}
 		}
+
+		new.IdentitySource = types.StringValue(accessGrantsInstanceIdentitySource(!newARN.IsNull()))
 	}
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
@@ -217,6 +274,12 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 		}
 	}
 
+	if err := new.refreshDefaultLocationRegistered(ctx, conn); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s) default location", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -256,8 +319,64 @@ func (r *accessGrantsInstanceResource) Delete(ctx context.Context, request resou
 
 func (r *accessGrantsInstanceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// default_location_registered is only refreshed while check_default_location
+	// is enabled, so the prior value can't be kept when the flag is toggled.
+	var planCheckDefaultLocation, stateCheckDefaultLocation types.Bool
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("check_default_location"), &planCheckDefaultLocation)...)
+
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("check_default_location"), &stateCheckDefaultLocation)...)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !planCheckDefaultLocation.IsUnknown() && !planCheckDefaultLocation.ValueBool() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("default_location_registered"), types.BoolNull())...)
+	} else if !planCheckDefaultLocation.Equal(stateCheckDefaultLocation) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("default_location_registered"), types.BoolUnknown())...)
+	}
+
+	// identity_source follows from identity_center_arn, so it can be planned
+	// rather than left unknown until apply.
+	var identityCenterARN fwtypes.ARN
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("identity_center_arn"), &identityCenterARN)...)
+
+	if response.Diagnostics.HasError() || identityCenterARN.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("identity_source"), accessGrantsInstanceIdentitySource(!identityCenterARN.IsNull()))...)
+}
+
+const (
+	accessGrantsIdentitySourceIAM            = "IAM"
+	accessGrantsIdentitySourceIdentityCenter = "IDENTITY_CENTER"
+)
+
+// accessGrantsInstanceIdentitySource returns the source of the identities that
+// grants in an S3 Access Grants instance are made to: IAM Identity Center if an
+// Identity Center instance is associated, otherwise IAM.
+func accessGrantsInstanceIdentitySource(identityCenterAssociated bool) string {
+	if identityCenterAssociated {
+		return accessGrantsIdentitySourceIdentityCenter
+	}
+
+	return accessGrantsIdentitySourceIAM
 }
 
+// associateAccessGrantsInstanceIdentityCenterInstance associates an IAM Identity
+// Center instance with the account's S3 Access Grants instance. It succeeds if
+// the same instance is already associated, e.g. by an earlier apply that failed
+// before saving state.
 func associateAccessGrantsInstanceIdentityCenterInstance(ctx context.Context, conn *s3control.Client, accountID, identityCenterARN string) error {
 	input := &s3control.AssociateAccessGrantsIdentityCenterInput{
 		AccountId:         aws.String(accountID),
@@ -266,9 +385,48 @@ func associateAccessGrantsInstanceIdentityCenterInstance(ctx context.Context, co
 
 	_, err := conn.AssociateAccessGrantsIdentityCenter(ctx, input)
 
+	// Only an association with the same instance is tolerated. Any other error,
+	// e.g. throttling or AccessDenied, is returned as is.
+	// The S3 Access Grants instance reports the ARN of the Identity Center
+	// application it created, not that of the instance, so the two are compared
+	// by Identity Center instance ID.
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRequest) {
+		if output, findErr := findAccessGrantsInstance(ctx, conn, accountID); findErr == nil {
+			if id := identityCenterInstanceID(aws.ToString(output.IdentityCenterArn)); id != "" && id == identityCenterInstanceID(identityCenterARN) {
+				return nil
+			}
+		}
+	}
+
 	return err
 }
 
+// identityCenterInstanceID returns the ID of the IAM Identity Center instance
+// from the ARN of the instance (arn:aws:sso:::instance/ssoins-…) or of one of
+// its applications (arn:aws:sso::123456789012:application/ssoins-…/apl-…).
+// It returns "" for any other value.
+func identityCenterInstanceID(v string) string {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] == "instance":
+	case len(parts) == 3 && parts[0] == "application":
+	default:
+		return ""
+	}
+
+	return parts[1]
+}
+
+// disassociateAccessGrantsInstanceIdentityCenterInstance dissociates any IAM
+// Identity Center instance from the account's S3 Access Grants instance. It
+// succeeds if no instance is associated.
 func disassociateAccessGrantsInstanceIdentityCenterInstance(ctx context.Context, conn *s3control.Client, accountID string) error {
 	input := &s3control.DissociateAccessGrantsIdentityCenterInput{
 		AccountId: aws.String(accountID),
@@ -276,9 +434,27 @@ func disassociateAccessGrantsInstanceIdentityCenterInstance(ctx context.Context,
 
 	_, err := conn.DissociateAccessGrantsIdentityCenter(ctx, input)
 
+	if err != nil {
+		if associated, findErr := accessGrantsInstanceIdentityCenterAssociated(ctx, conn, accountID); findErr == nil && !associated {
+			return nil
+		}
+	}
+
 	return err
 }
 
+// accessGrantsInstanceIdentityCenterAssociated returns whether an IAM Identity
+// Center instance is associated with the account's S3 Access Grants instance.
+func accessGrantsInstanceIdentityCenterAssociated(ctx context.Context, conn *s3control.Client, accountID string) (bool, error) {
+	output, err := findAccessGrantsInstance(ctx, conn, accountID)
+
+	if err != nil {
+		return false, err
+	}
+
+	return aws.ToString(output.IdentityCenterArn) != "", nil
+}
+
 func findAccessGrantsInstance(ctx context.Context, conn *s3control.Client, accountID string) (*s3control.GetAccessGrantsInstanceOutput, error) {
 	input := &s3control.GetAccessGrantsInstanceInput{
 		AccountId: aws.String(accountID),
@@ -304,13 +480,32 @@ func findAccessGrantsInstance(ctx context.Context, conn *s3control.Client, accou
 	return output, nil
 }
 
+// waitAccessGrantsInstanceCreated waits for a newly created Access Grants
+// instance to become visible, as GetAccessGrantsInstance is eventually
+// consistent and can briefly report it as not found.
+func waitAccessGrantsInstanceCreated(ctx context.Context, conn *s3control.Client, accountID string, timeout time.Duration) (*s3control.GetAccessGrantsInstanceOutput, error) {
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		return findAccessGrantsInstance(ctx, conn, accountID)
+	})
+
+	if output, ok := outputRaw.(*s3control.GetAccessGrantsInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 type accessGrantsInstanceResourceModel struct {
 	AccessGrantsInstanceARN      types.String `tfsdk:"access_grants_instance_arn"`
 	AccessGrantsInstanceID       types.String `tfsdk:"access_grants_instance_id"`
 	AccountID                    types.String `tfsdk:"account_id"`
+	CheckDefaultLocation         types.Bool   `tfsdk:"check_default_location"`
+	DefaultLocationRegistered    types.Bool   `tfsdk:"default_location_registered"`
 	ID                           types.String `tfsdk:"id"`
 	IdentityCenterApplicationARN types.String `tfsdk:"identity_center_application_arn"`
 	IdentityCenterARN            fwtypes.ARN  `tfsdk:"identity_center_arn"`
+	IdentitySource               types.String `tfsdk:"identity_source"`
+	Region                       types.String `tfsdk:"region"`
 	Tags                         types.Map    `tfsdk:"tags"`
 	TagsAll                      types.Map    `tfsdk:"tags_all"`
 }
@@ -324,3 +519,48 @@ func (data *accessGrantsInstanceResourceModel) InitFromID() error {
 func (data *accessGrantsInstanceResourceModel) setID() {
 	data.ID = data.AccountID
 }
+
+// setRegion sets the Region that the instance is in. Instances are regional
+// but their ID is only the account ID, so the Region is taken from the
+// instance ARN, falling back to the provider's Region.
+func (data *accessGrantsInstanceResourceModel) setRegion(providerRegion string) {
+	if v, err := arn.Parse(data.AccessGrantsInstanceARN.ValueString()); err == nil && v.Region != "" {
+		data.Region = types.StringValue(v.Region)
+
+		return
+	}
+
+	data.Region = types.StringValue(providerRegion)
+}
+
+// refreshDefaultLocationRegistered sets whether the default S3 Access Grants
+// location (s3://) is registered. The lookup costs an additional API call, so
+// it's only made when opted in via check_default_location.
+func (data *accessGrantsInstanceResourceModel) refreshDefaultLocationRegistered(ctx context.Context, conn *s3control.Client) error {
+	if data.CheckDefaultLocation.IsNull() {
+		// e.g. on import.
+		data.CheckDefaultLocation = types.BoolValue(false)
+	}
+
+	if !data.CheckDefaultLocation.ValueBool() {
+		data.DefaultLocationRegistered = types.BoolNull()
+
+		return nil
+	}
+
+	_, err := findAccessGrantsLocationByScope(ctx, conn, data.AccountID.ValueString(), accessGrantsDefaultLocationScope)
+
+	if tfresource.NotFound(err) {
+		data.DefaultLocationRegistered = types.BoolValue(false)
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	data.DefaultLocationRegistered = types.BoolValue(true)
+
+	return nil
+}
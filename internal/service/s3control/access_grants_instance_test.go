@@ -6,8 +6,10 @@ package s3control_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,6 +19,142 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFindAccessGrantsInstance(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID   = "123456789012"
+		instanceARN = "arn:aws:s3:us-west-2:123456789012:access-grants/default" //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		response       fakeResponse
+		expectNotFound bool
+		expectError    bool
+	}{
+		"found": {
+			response: fakeResponse{
+				statusCode: http.StatusOK,
+				body:       fmt.Sprintf(`<GetAccessGrantsInstanceResult><AccessGrantsInstanceArn>%s</AccessGrantsInstanceArn><AccessGrantsInstanceId>default</AccessGrantsInstanceId></GetAccessGrantsInstanceResult>`, instanceARN),
+			},
+		},
+		// Retried by waitAccessGrantsInstanceCreated, as the instance can briefly be missing after create.
+		"not found": {
+			response: fakeResponse{
+				statusCode: http.StatusNotFound,
+				body:       `<Error><Code>AccessGrantsInstanceNotExistsError</Code><Message>Access Grants Instance does not exist</Message></Error>`,
+			},
+			expectNotFound: true,
+		},
+		"access denied": {
+			response: fakeResponse{
+				statusCode: http.StatusForbidden,
+				body:       `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newFakeClient(testCase.response)
+
+			output, err := tfs3control.FindAccessGrantsInstance(context.Background(), conn, accountID)
+
+			if got := tfresource.NotFound(err); got != testCase.expectNotFound {
+				t.Fatalf("NotFound = %t, want %t (error: %v)", got, testCase.expectNotFound, err)
+			}
+
+			if testCase.expectNotFound {
+				return
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.AccessGrantsInstanceArn), instanceARN; got != want {
+				t.Errorf("AccessGrantsInstanceArn = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAssociateAccessGrantsInstanceIdentityCenterInstance(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID         = "123456789012"
+		identityCenterARN = "arn:aws:sso:::instance/ssoins-1234567890abcdef"
+	)
+
+	associated := func(applicationARN string) fakeResponse {
+		return fakeResponse{
+			statusCode: http.StatusOK,
+			body:       fmt.Sprintf(`<GetAccessGrantsInstanceResult><AccessGrantsInstanceId>default</AccessGrantsInstanceId><IdentityCenterArn>%s</IdentityCenterArn></GetAccessGrantsInstanceResult>`, applicationARN),
+		}
+	}
+	invalidRequest := fakeResponse{
+		statusCode: http.StatusBadRequest,
+		body:       `<ErrorResponse><Error><Code>InvalidRequest</Code><Message>Identity Center instance is already associated</Message></Error></ErrorResponse>`,
+	}
+
+	testCases := map[string]struct {
+		responses   []fakeResponse
+		expectError bool
+	}{
+		"associated": {
+			responses: []fakeResponse{{statusCode: http.StatusOK}},
+		},
+		"already associated with the same instance": {
+			responses: []fakeResponse{
+				invalidRequest,
+				associated("arn:aws:sso::123456789012:application/ssoins-1234567890abcdef/apl-1234567890abcdef"),
+			},
+		},
+		"already associated with another instance": {
+			responses: []fakeResponse{
+				invalidRequest,
+				associated("arn:aws:sso::123456789012:application/ssoins-fedcba0987654321/apl-1234567890abcdef"),
+			},
+			expectError: true,
+		},
+		"access denied": {
+			responses: []fakeResponse{{
+				statusCode: http.StatusForbidden,
+				body:       `<ErrorResponse><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error></ErrorResponse>`,
+			}},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newFakeClient(testCase.responses...)
+
+			err := tfs3control.AssociateAccessGrantsInstanceIdentityCenterInstance(context.Background(), conn, accountID, identityCenterARN)
+
+			if got := err != nil; got != testCase.expectError {
+				t.Errorf("error = %v, expectError %t", err, testCase.expectError)
+			}
+		})
+	}
+}
+
 func testAccAccessGrantsInstance_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_s3control_access_grants_instance.test"
@@ -34,8 +172,12 @@ func testAccAccessGrantsInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_id"),
 					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "check_default_location", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "default_location_registered"),
 					resource.TestCheckNoResourceAttr(resourceName, "identity_center_application_arn"),
 					resource.TestCheckNoResourceAttr(resourceName, "identity_center_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_source", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.Region()),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -130,6 +272,7 @@ func testAccAccessGrantsInstance_identityCenter(t *testing.T) {
 					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_center_application_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_center_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_source", "IDENTITY_CENTER"),
 				),
 			},
 			{
@@ -144,7 +287,39 @@ func testAccAccessGrantsInstance_identityCenter(t *testing.T) {
 					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
 					resource.TestCheckNoResourceAttr(resourceName, "identity_center_application_arn"),
 					resource.TestCheckNoResourceAttr(resourceName, "identity_center_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_source", "IAM"),
+				),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_identityCenter(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_center_application_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_center_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_source", "IDENTITY_CENTER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_identityCenterDissociated(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_identityCenter(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					testAccCheckAccessGrantsInstanceDissociateIdentityCenter(ctx, resourceName),
 				),
+				ExpectNonEmptyPlan: true,
 			},
 			{
 				Config: testAccAccessGrantsInstanceConfig_identityCenter(),
@@ -158,6 +333,82 @@ func testAccAccessGrantsInstance_identityCenter(t *testing.T) {
 	})
 }
 
+func testAccAccessGrantsInstance_defaultLocation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_defaultLocation(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check_default_location", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_location_registered", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"check_default_location", "default_location_registered"},
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check_default_location", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "default_location_registered"),
+				),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_defaultLocation(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check_default_location", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_location_registered", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_multipleRegions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+	alternateResourceName := "aws_s3control_access_grants_instance.alternate"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_multipleRegions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.Region()),
+					resource.TestCheckResourceAttr(alternateResourceName, "region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", alternateResourceName, "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAccessGrantsInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -199,6 +450,21 @@ func testAccCheckAccessGrantsInstanceExists(ctx context.Context, n string) resou
 	}
 }
 
+// testAccCheckAccessGrantsInstanceDissociateIdentityCenter dissociates the
+// instance's IAM Identity Center instance outside of Terraform.
+func testAccCheckAccessGrantsInstanceDissociateIdentityCenter(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		return tfs3control.DisassociateAccessGrantsInstanceIdentityCenterInstance(ctx, conn, rs.Primary.ID)
+	}
+}
+
 func testAccAccessGrantsInstanceConfig_basic() string {
 	return `
 resource "aws_s3control_access_grants_instance" "test" {}
@@ -235,3 +501,21 @@ resource "aws_s3control_access_grants_instance" "test" {
 }
 `
 }
+
+func testAccAccessGrantsInstanceConfig_multipleRegions() string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), `
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3control_access_grants_instance" "alternate" {
+  provider = awsalternate
+}
+`)
+}
+
+func testAccAccessGrantsInstanceConfig_defaultLocation() string {
+	return `
+resource "aws_s3control_access_grants_instance" "test" {
+  check_default_location = true
+}
+`
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -289,6 +290,37 @@ func findAccessGrantsLocationByTwoPartKey(ctx context.Context, conn *s3control.C
 	return output, nil
 }
 
+func findAccessGrantsLocationByScope(ctx context.Context, conn *s3control.Client, accountID, locationScope string) (*awstypes.ListAccessGrantsLocationsEntry, error) {
+	input := &s3control.ListAccessGrantsLocationsInput{
+		AccountId:     aws.String(accountID),
+		LocationScope: aws.String(locationScope),
+	}
+
+	pages := s3control.NewListAccessGrantsLocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AccessGrantsLocationsList {
+			if aws.ToString(v.LocationScope) == locationScope {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 type accessGrantsLocationResourceModel struct {
 	AccessGrantsLocationARN types.String `tfsdk:"access_grants_location_arn"`
 	AccessGrantsLocationID  types.String `tfsdk:"access_grants_location_id"`
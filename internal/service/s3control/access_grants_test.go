@@ -14,10 +14,13 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Instance": {
-			"basic":          testAccAccessGrantsInstance_basic,
-			"disappears":     testAccAccessGrantsInstance_disappears,
-			"tags":           testAccAccessGrantsInstance_tags,
-			"identityCenter": testAccAccessGrantsInstance_identityCenter,
+			"basic":                     testAccAccessGrantsInstance_basic,
+			"disappears":                testAccAccessGrantsInstance_disappears,
+			"tags":                      testAccAccessGrantsInstance_tags,
+			"identityCenter":            testAccAccessGrantsInstance_identityCenter,
+			"identityCenterDissociated": testAccAccessGrantsInstance_identityCenterDissociated,
+			"defaultLocation":           testAccAccessGrantsInstance_defaultLocation,
+			"multipleRegions":           testAccAccessGrantsInstance_multipleRegions,
 		},
 		"Location": {
 			"basic":      testAccAccessGrantsLocation_basic,
@@ -26,15 +29,21 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			"update":     testAccAccessGrantsLocation_update,
 		},
 		"Grant": {
-			"basic":                 testAccAccessGrant_basic,
-			"disappears":            testAccAccessGrant_disappears,
-			"tags":                  testAccAccessGrant_tags,
-			"locationConfiguration": testAccAccessGrant_locationConfiguration,
+			"basic":                    testAccAccessGrant_basic,
+			"disappears":               testAccAccessGrant_disappears,
+			"tags":                     testAccAccessGrant_tags,
+			"locationConfiguration":    testAccAccessGrant_locationConfiguration,
+			"prefixLevel":              testAccAccessGrant_prefixLevel,
+			"granteeIdentifierInvalid": testAccAccessGrant_granteeIdentifierInvalid,
+			"s3SubPrefixInvalid":       testAccAccessGrant_s3SubPrefixInvalid,
 		},
 		"InstanceResourcePolicy": {
 			"basic":      testAccAccessGrantsInstanceResourcePolicy_basic,
 			"disappears": testAccAccessGrantsInstanceResourcePolicy_disappears,
 		},
+		"ResourceTagsDataSource": {
+			"basic": testAccResourceTagsDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -15,16 +16,21 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_s3_access_point")
+// @SDKResource("aws_s3_access_point", name="Access Point")
+// @Tags
 func resourceAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPointCreate,
@@ -36,6 +42,10 @@ func resourceAccessPoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:         schema.TypeString,
@@ -136,6 +146,8 @@ func resourceAccessPoint() *schema.Resource {
 					},
 				},
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"vpc_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -153,7 +165,57 @@ func resourceAccessPoint() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAccessPointCustomizeDiff,
+		),
+	}
+}
+
+func resourceAccessPointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+
+	// Only access points in an AWS Region can be tagged.
+	// Provider default_tags are left out for S3 on Outposts access points.
+	if accessPointBucketIsOutposts(bucket) {
+		if v := d.GetRawConfig().GetAttr(names.AttrTags); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("tags aren't supported for S3 on Outposts access points (bucket %s)", bucket)
+		}
+
+		if err := d.SetNew(names.AttrTagsAll, map[string]interface{}{}); err != nil {
+			return err
+		}
 	}
+
+	if d.Id() == "" || !d.HasChange("vpc_configuration") {
+		return nil
+	}
+
+	o, n := d.GetChange("vpc_configuration")
+
+	return validateAccessPointVPCConfigurationChange(bucket, o.([]interface{}), n.([]interface{}))
+}
+
+// validateAccessPointVPCConfigurationChange rejects removing vpc_configuration from an
+// S3 on Outposts access point. Such access points can only have a VPC network origin, so
+// the replacement that removal plans would fail in CreateAccessPoint.
+func validateAccessPointVPCConfigurationChange(bucket string, o, n []interface{}) error {
+	if len(o) == 0 || len(n) > 0 {
+		return nil
+	}
+
+	if !accessPointBucketIsOutposts(bucket) {
+		return nil
+	}
+
+	return fmt.Errorf("vpc_configuration can't be removed from an S3 on Outposts access point (bucket %s): Outposts access points must have a VPC network origin", bucket)
+}
+
+func accessPointBucketIsOutposts(bucket string) bool {
+	parsedARN, err := arn.Parse(bucket)
+
+	return err == nil && parsedARN.Service == "s3-outposts"
 }
 
 func resourceAccessPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -182,10 +244,12 @@ func resourceAccessPointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.VpcConfiguration = expandVPCConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	output, err := conn.CreateAccessPoint(ctx, input)
+	output, err := retryWhenThrottled(ctx, d.Timeout(schema.TimeoutCreate), func() (*s3control.CreateAccessPointOutput, error) {
+		return conn.CreateAccessPoint(ctx, input)
+	})
 
 	if err != nil {
-		return diag.Errorf("creating S3 Access Point (%s): %s", name, err)
+		return create.DiagErrorfWithCode(err, "creating S3 Access Point (%s): %s", name, withHTTPStatusCode(err))
 	}
 
 	resourceID, err := AccessPointCreateResourceID(aws.ToString(output.AccessPointArn))
@@ -200,6 +264,19 @@ func resourceAccessPointCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(resourceID)
 
+	if !accessPointBucketIsOutposts(d.Get("bucket").(string)) {
+		if _, err := waitAccessPointAliasAvailable(ctx, conn, accountID, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagErrorfWithCode(err, "waiting for S3 Access Point (%s) alias: %s", d.Id(), withHTTPStatusCode(err))
+		}
+	}
+
+	// CreateAccessPoint doesn't accept tags, so they're applied once the access point exists.
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 && !accessPointBucketIsOutposts(d.Get("bucket").(string)) {
+		if err := updateTags(ctx, conn, aws.ToString(output.AccessPointArn), accountID, nil, tags); err != nil {
+			return create.DiagErrorfWithCode(err, "setting S3 Access Point (%s) tags: %s", d.Id(), withHTTPStatusCode(err))
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok && v.(string) != "" && v.(string) != "{}" {
 		policy, err := structure.NormalizeJsonString(v.(string))
 		if err != nil {
@@ -212,10 +289,12 @@ func resourceAccessPointCreate(ctx context.Context, d *schema.ResourceData, meta
 			Policy:    aws.String(policy),
 		}
 
-		_, err = conn.PutAccessPointPolicy(ctx, input)
+		_, err = retryWhenThrottled(ctx, d.Timeout(schema.TimeoutCreate), func() (*s3control.PutAccessPointPolicyOutput, error) {
+			return conn.PutAccessPointPolicy(ctx, input)
+		})
 
 		if err != nil {
-			return diag.Errorf("creating S3 Access Point (%s) policy: %s", d.Id(), err)
+			return create.DiagErrorfWithCode(err, "creating S3 Access Point (%s) policy: %s", d.Id(), withHTTPStatusCode(err))
 		}
 	}
 
@@ -239,7 +318,7 @@ func resourceAccessPointRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return diag.Errorf("reading S3 Access Point (%s): %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "reading S3 Access Point (%s): %s", d.Id(), withHTTPStatusCode(err))
 	}
 
 	s3OnOutposts := arn.IsARN(name)
@@ -321,7 +400,22 @@ func resourceAccessPointRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("has_public_access_policy", false)
 		d.Set("policy", nil)
 	} else {
-		return diag.Errorf("reading S3 Access Point (%s) policy: %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "reading S3 Access Point (%s) policy: %s", d.Id(), withHTTPStatusCode(err))
+	}
+
+	if !s3OnOutposts {
+		tags, err := listTags(ctx, conn, d.Get("arn").(string), accountID)
+
+		switch {
+		// As for Multi-Region Access Points, failing to list tags only matters if
+		// tags are configured.
+		case isListTagsUnsupportedError(err) && len(d.Get(names.AttrTagsAll).(map[string]interface{})) == 0:
+			log.Printf("[DEBUG] Skipping tags for S3 Access Point (%s): %s", d.Id(), err)
+		case err != nil:
+			return create.DiagErrorfWithCode(err, "listing tags for S3 Access Point (%s): %s", d.Id(), withHTTPStatusCode(err))
+		default:
+			setTagsOut(ctx, Tags(tags))
+		}
 	}
 
 	return nil
@@ -348,10 +442,12 @@ func resourceAccessPointUpdate(ctx context.Context, d *schema.ResourceData, meta
 				Policy:    aws.String(policy),
 			}
 
-			_, err = conn.PutAccessPointPolicy(ctx, input)
+			_, err = retryWhenThrottled(ctx, d.Timeout(schema.TimeoutUpdate), func() (*s3control.PutAccessPointPolicyOutput, error) {
+				return conn.PutAccessPointPolicy(ctx, input)
+			})
 
 			if err != nil {
-				return diag.Errorf("updating S3 Access Point (%s) policy: %s", d.Id(), err)
+				return create.DiagErrorfWithCode(err, "updating S3 Access Point (%s) policy: %s", d.Id(), withHTTPStatusCode(err))
 			}
 		} else {
 			input := &s3control.DeleteAccessPointPolicyInput{
@@ -359,14 +455,24 @@ func resourceAccessPointUpdate(ctx context.Context, d *schema.ResourceData, meta
 				Name:      aws.String(name),
 			}
 
-			_, err := conn.DeleteAccessPointPolicy(ctx, input)
+			_, err := retryWhenThrottled(ctx, d.Timeout(schema.TimeoutUpdate), func() (*s3control.DeleteAccessPointPolicyOutput, error) {
+				return conn.DeleteAccessPointPolicy(ctx, input)
+			})
 
 			if err != nil {
-				return diag.Errorf("deleting S3 Access Point (%s) policy: %s", d.Id(), err)
+				return create.DiagErrorfWithCode(err, "deleting S3 Access Point (%s) policy: %s", d.Id(), withHTTPStatusCode(err))
 			}
 		}
 	}
 
+	if d.HasChange(names.AttrTagsAll) && !arn.IsARN(name) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := updateTags(ctx, conn, d.Get("arn").(string), accountID, o, n); err != nil {
+			return create.DiagErrorfWithCode(err, "updating S3 Access Point (%s) tags: %s", d.Id(), withHTTPStatusCode(err))
+		}
+	}
+
 	return resourceAccessPointRead(ctx, d, meta)
 }
 
@@ -379,9 +485,11 @@ func resourceAccessPointDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	log.Printf("[DEBUG] Deleting S3 Access Point: %s", d.Id())
-	_, err = conn.DeleteAccessPoint(ctx, &s3control.DeleteAccessPointInput{
-		AccountId: aws.String(accountID),
-		Name:      aws.String(name),
+	_, err = retryWhenThrottled(ctx, d.Timeout(schema.TimeoutDelete), func() (*s3control.DeleteAccessPointOutput, error) {
+		return conn.DeleteAccessPoint(ctx, &s3control.DeleteAccessPointInput{
+			AccountId: aws.String(accountID),
+			Name:      aws.String(name),
+		})
 	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint) {
@@ -389,7 +497,7 @@ func resourceAccessPointDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if err != nil {
-		return diag.Errorf("deleting S3 Access Point (%s): %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "deleting S3 Access Point (%s): %s", d.Id(), withHTTPStatusCode(err))
 	}
 
 	return nil
@@ -421,6 +529,74 @@ func findAccessPointByTwoPartKey(ctx context.Context, conn *s3control.Client, ac
 	return output, nil
 }
 
+const (
+	accessPointAliasStatusAvailable = "available"
+	accessPointAliasStatusPending   = "pending"
+)
+
+func statusAccessPointAlias(ctx context.Context, conn *s3control.Client, accountID, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAccessPointByTwoPartKey(ctx, conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.ToString(output.Alias) == "" {
+			return output, accessPointAliasStatusPending, nil
+		}
+
+		return output, accessPointAliasStatusAvailable, nil
+	}
+}
+
+// waitAccessPointAliasAvailable waits for a newly created access point's alias
+// to be returned by GetAccessPoint. It can briefly be missing after create.
+func waitAccessPointAliasAvailable(ctx context.Context, conn *s3control.Client, accountID, name string, timeout time.Duration) (*s3control.GetAccessPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"", accessPointAliasStatusPending},
+		Target:     []string{accessPointAliasStatusAvailable},
+		Refresh:    statusAccessPointAlias(ctx, conn, accountID, name),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*s3control.GetAccessPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func findAccessPointByAlias(ctx context.Context, conn *s3control.Client, accountID, alias string) (*types.AccessPoint, error) {
+	input := &s3control.ListAccessPointsInput{
+		AccountId: aws.String(accountID),
+	}
+
+	pages := s3control.NewListAccessPointsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AccessPointList {
+			if aws.ToString(v.Alias) == alias {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 const accessPointResourceIDSeparator = ":"
 
 func AccessPointCreateResourceID(accessPointARN string) (string, error) {
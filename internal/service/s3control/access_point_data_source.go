@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_s3_access_point", name="Access Point")
+func dataSourceAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidAccountID,
+				ConflictsWith: []string{"arn"},
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"network_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccessPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	var accountID, name string
+
+	if v, ok := d.GetOk("arn"); ok {
+		resourceID, err := AccessPointCreateResourceID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accountID, name, err = AccessPointParseResourceID(resourceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		accountID = meta.(*conns.AWSClient).AccountID
+		if v, ok := d.GetOk("account_id"); ok {
+			accountID = v.(string)
+		}
+		name = d.Get("name").(string)
+	}
+
+	output, err := findAccessPointByTwoPartKey(ctx, conn, accountID, name)
+
+	if err != nil {
+		return create.DiagErrorfWithCode(err, "reading S3 Access Point (%s): %s", name, withHTTPStatusCode(err))
+	}
+
+	// S3 on Outposts access points are identified by their ARN.
+	accessPointARN := name
+	if !arn.IsARN(name) {
+		// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazons3.html#amazons3-resources-for-iam-policies.
+		accessPointARN = arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "s3",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: accountID,
+			Resource:  fmt.Sprintf("accesspoint/%s", aws.ToString(output.Name)),
+		}.String()
+	}
+
+	d.SetId(accessPointARN)
+	d.Set("account_id", accountID)
+	d.Set("alias", output.Alias)
+	d.Set("arn", accessPointARN)
+	d.Set("bucket", output.Bucket)
+	d.Set("bucket_account_id", output.BucketAccountId)
+	d.Set("endpoints", output.Endpoints)
+	d.Set("name", output.Name)
+	d.Set("network_origin", output.NetworkOrigin)
+	if output.VpcConfiguration != nil {
+		if err := d.Set("vpc_configuration", []interface{}{flattenVPCConfiguration(output.VpcConfiguration)}); err != nil {
+			return diag.Errorf("setting vpc_configuration: %s", err)
+		}
+	} else {
+		d.Set("vpc_configuration", nil)
+	}
+
+	return nil
+}
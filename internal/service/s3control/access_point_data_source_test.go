@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlAccessPointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+	dataSourceByName := "data.aws_s3_access_point.by_name"
+	dataSourceByARN := "data.aws_s3_access_point.by_arn"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "bucket", resourceName, "bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "endpoints.%", resourceName, "endpoints.%"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceByName, "network_origin", "VPC"),
+					resource.TestCheckResourceAttr(dataSourceByName, "vpc_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "vpc_configuration.0.vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByARN, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByARN, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceByARN, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceByARN, "network_origin", "VPC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessPointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointConfig_vpc(rName), `
+data "aws_s3_access_point" "by_name" {
+  name = aws_s3_access_point.test.name
+}
+
+data "aws_s3_access_point" "by_arn" {
+  arn = aws_s3_access_point.test.arn
+}
+`)
+}
@@ -5,6 +5,8 @@ package s3control
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,16 +36,29 @@ func resourceAccessPointPolicy() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_point_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"access_point_alias", "access_point_arn"},
+			},
 			"access_point_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"access_point_alias", "access_point_arn"},
 			},
 			"has_public_access_policy": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"policy": {
 				Type:                  schema.TypeString,
 				Required:              true,
@@ -62,7 +77,20 @@ func resourceAccessPointPolicy() *schema.Resource {
 func resourceAccessPointPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
 
-	resourceID, err := AccessPointCreateResourceID(d.Get("access_point_arn").(string))
+	accessPointARN := d.Get("access_point_arn").(string)
+	if v, ok := d.GetOk("access_point_alias"); ok {
+		alias := v.(string)
+		accessPoint, err := findAccessPointByAlias(ctx, conn, meta.(*conns.AWSClient).AccountID, alias)
+
+		if err != nil {
+			return diag.Errorf("creating S3 Access Point Policy: finding S3 Access Point by alias (%s): %s", alias, err)
+		}
+
+		accessPointARN = aws.ToString(accessPoint.AccessPointArn)
+		d.Set("access_point_arn", accessPointARN)
+	}
+
+	resourceID, err := AccessPointCreateResourceID(accessPointARN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -77,6 +105,12 @@ func resourceAccessPointPolicyCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if !d.Get("overwrite").(bool) {
+		if err := checkAccessPointPolicyNotExists(ctx, conn, accountID, name); err != nil {
+			return diag.Errorf("creating S3 Access Point (%s) Policy: %s", resourceID, err)
+		}
+	}
+
 	input := &s3control.PutAccessPointPolicyInput{
 		AccountId: aws.String(accountID),
 		Name:      aws.String(name),
@@ -191,38 +225,65 @@ func resourceAccessPointPolicyImport(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("access_point_arn", d.Id())
+	d.Set("overwrite", false)
 	d.SetId(resourceID)
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func findAccessPointPolicyAndStatusByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (string, *types.PolicyStatus, error) {
-	inputGAPP := &s3control.GetAccessPointPolicyInput{
+// checkAccessPointPolicyNotExists returns an error if the specified access point already has a policy,
+// so that a policy managed elsewhere, e.g. on a shared access point, isn't silently replaced.
+func checkAccessPointPolicyNotExists(ctx context.Context, conn *s3control.Client, accountID, name string) error {
+	_, err := findAccessPointPolicyByTwoPartKey(ctx, conn, accountID, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading existing policy: %w", err)
+	}
+
+	return errors.New("access point already has a policy, set overwrite to true to replace it")
+}
+
+func findAccessPointPolicyByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (string, error) {
+	input := &s3control.GetAccessPointPolicyInput{
 		AccountId: aws.String(accountID),
 		Name:      aws.String(name),
 	}
 
-	outputGAPP, err := conn.GetAccessPointPolicy(ctx, inputGAPP)
+	output, err := conn.GetAccessPointPolicy(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint, errCodeNoSuchAccessPointPolicy) {
-		return "", nil, &retry.NotFoundError{
+		return "", &retry.NotFoundError{
 			LastError:   err,
-			LastRequest: inputGAPP,
+			LastRequest: input,
 		}
 	}
 
 	if err != nil {
-		return "", nil, err
+		return "", err
 	}
 
-	if outputGAPP == nil {
-		return "", nil, tfresource.NewEmptyResultError(inputGAPP)
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
 	}
 
-	policy := aws.ToString(outputGAPP.Policy)
+	policy := aws.ToString(output.Policy)
 
 	if policy == "" {
-		return "", nil, tfresource.NewEmptyResultError(inputGAPP)
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return policy, nil
+}
+
+func findAccessPointPolicyAndStatusByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (string, *types.PolicyStatus, error) {
+	policy, err := findAccessPointPolicyByTwoPartKey(ctx, conn, accountID, name)
+
+	if err != nil {
+		return "", nil, err
 	}
 
 	inputGAPPS := &s3control.GetAccessPointPolicyStatusInput{
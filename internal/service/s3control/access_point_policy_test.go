@@ -6,11 +6,13 @@ package s3control_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,6 +21,54 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestCheckAccessPointPolicyNotExists(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statusCode  int
+		body        string
+		expectError bool
+	}{
+		"no policy": {
+			statusCode: http.StatusNotFound,
+			body:       `<ErrorResponse><Error><Code>NoSuchAccessPointPolicy</Code><Message>The specified accesspoint does not have a policy</Message></Error></ErrorResponse>`,
+		},
+		"empty policy": {
+			statusCode: http.StatusOK,
+			body:       `<GetAccessPointPolicyResult><Policy></Policy></GetAccessPointPolicyResult>`,
+		},
+		"existing policy": {
+			statusCode:  http.StatusOK,
+			body:        `<GetAccessPointPolicyResult><Policy>{"Version":"2012-10-17","Statement":[]}</Policy></GetAccessPointPolicyResult>`,
+			expectError: true,
+		},
+		"access denied": {
+			statusCode:  http.StatusForbidden,
+			body:        `<ErrorResponse><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error></ErrorResponse>`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newFakeClient(fakeResponse{statusCode: testCase.statusCode, body: testCase.body})
+
+			err := tfs3control.CheckAccessPointPolicyNotExists(context.Background(), conn, "123456789012", "example")
+
+			if err != nil && !testCase.expectError {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectError {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
 func TestAccS3ControlAccessPointPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_s3control_access_point_policy.test"
@@ -48,6 +98,39 @@ func TestAccS3ControlAccessPointPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlAccessPointPolicy_accessPointAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_point_policy.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointPolicyConfig_accessPointAlias(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "access_point_alias", accessPointResourceName, "alias"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_point_arn", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "has_public_access_policy", "false"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexache.MustCompile(`s3:GetObjectTagging`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAccessPointPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_point_alias"},
+			},
+		},
+	})
+}
+
 func TestAccS3ControlAccessPointPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_s3control_access_point_policy.test"
@@ -132,6 +215,69 @@ func TestAccS3ControlAccessPointPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlAccessPointPolicy_statementOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_point_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointPolicyConfig_statementOrder(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointPolicyExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccAccessPointPolicyConfig_statementOrder(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPointPolicy_overwrite(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_point_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessPointPolicyConfig_overwrite(rName, false),
+				ExpectError: regexache.MustCompile(`access point already has a policy`),
+			},
+			{
+				Config: testAccAccessPointPolicyConfig_overwrite(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "overwrite", "true"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexache.MustCompile(`s3:GetObjectTagging`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAccessPointPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+		},
+	})
+}
+
 func testAccAccessPointPolicyImportStateIdFunc(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -241,6 +387,77 @@ resource "aws_s3control_access_point_policy" "test" {
 `)
 }
 
+func testAccAccessPointPolicyConfig_overwrite(rName string, overwrite bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+# The access point is created with a policy that is then managed separately.
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3:GetObject"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Resource = "arn:${data.aws_partition.current.partition}:s3:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:accesspoint/%[1]s/object/*"
+    }]
+  })
+
+  lifecycle {
+    ignore_changes = [policy]
+  }
+}
+
+resource "aws_s3control_access_point_policy" "test" {
+  access_point_arn = aws_s3_access_point.test.arn
+  overwrite        = %[2]t
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3:GetObjectTagging"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/*"
+    }]
+  })
+}
+`, rName, overwrite)
+}
+
+func testAccAccessPointPolicyConfig_accessPointAlias(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPointPolicyConfig_base(rName), `
+resource "aws_s3control_access_point_policy" "test" {
+  access_point_alias = aws_s3_access_point.test.alias
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "s3:GetObjectTagging"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/*"
+    }]
+  })
+}
+`)
+}
+
 func testAccAccessPointPolicyConfig_updated(rName string) string {
 	return acctest.ConfigCompose(testAccAccessPointPolicyConfig_base(rName), `
 resource "aws_s3control_access_point_policy" "test" {
@@ -263,3 +480,40 @@ resource "aws_s3control_access_point_policy" "test" {
 }
 `)
 }
+
+func testAccAccessPointPolicyConfig_statementOrder(rName string, reversed bool) string {
+	statements := []string{`{
+      Sid    = "GetObjectTagging"
+      Effect = "Allow"
+      Action = "s3:GetObjectTagging"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/*"
+    }`, `{
+      Sid    = "GetObjectRetention"
+      Effect = "Allow"
+      Action = ["s3:GetObjectRetention", "s3:GetObjectLegalHold"]
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Resource = "${aws_s3_access_point.test.arn}/object/prefix/*"
+    }`}
+	if reversed {
+		statements[0], statements[1] = statements[1], statements[0]
+	}
+
+	return acctest.ConfigCompose(testAccAccessPointPolicyConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_access_point_policy" "test" {
+  access_point_arn = aws_s3_access_point.test.arn
+
+  policy = jsonencode({
+    Version = "2008-10-17"
+    Statement = [
+    %[1]s,
+    %[2]s,
+    ]
+  })
+}
+`, statements[0], statements[1]))
+}
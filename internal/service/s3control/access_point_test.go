@@ -6,6 +6,7 @@ package s3control_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -13,6 +14,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -130,6 +132,37 @@ func TestAccS3ControlAccessPoint_Bucket_arn(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:      testAccAccessPointConfig_bucketARNNoVPC(rName),
+				ExpectError: regexache.MustCompile(`vpc_configuration can't be removed from an S3 on Outposts access point`),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPoint_Bucket_arnDefaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccAccessPointConfig_bucketARN(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
 		},
 	})
 }
@@ -264,6 +297,22 @@ func TestAccS3ControlAccessPoint_publicAccessBlock(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAccessPointConfig_publicBlockPolicyOnly(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.block_public_policy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.ignore_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public_access_block_configuration.0.restrict_public_buckets", "true"),
+				),
+			},
 		},
 	})
 }
@@ -306,10 +355,194 @@ func TestAccS3ControlAccessPoint_vpc(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAccessPointConfig_basic(rName, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_origin", "Internet"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlAccessPoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3control.GetAccessPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessPointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAccessPointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAccessPointConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
 		},
 	})
 }
 
+func TestStatusAccessPointAlias(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		alias     = "example-abcdefghijklmnopqrstuvwxyz012-s3alias"
+		name      = "example"
+	)
+
+	testCases := map[string]struct {
+		response      fakeResponse
+		expectedState string
+		expectError   bool
+	}{
+		"alias available": {
+			response: fakeResponse{
+				statusCode: http.StatusOK,
+				body:       fmt.Sprintf(`<GetAccessPointResult><Name>%s</Name><Bucket>example-bucket</Bucket><Alias>%s</Alias></GetAccessPointResult>`, name, alias),
+			},
+			expectedState: tfs3control.AccessPointAliasStatusAvailable,
+		},
+		"alias pending": {
+			response: fakeResponse{
+				statusCode: http.StatusOK,
+				body:       fmt.Sprintf(`<GetAccessPointResult><Name>%s</Name><Bucket>example-bucket</Bucket></GetAccessPointResult>`, name),
+			},
+			expectedState: tfs3control.AccessPointAliasStatusPending,
+		},
+		"not found": {
+			response: fakeResponse{
+				statusCode: http.StatusNotFound,
+				body:       `<ErrorResponse><Error><Code>NoSuchAccessPoint</Code><Message>The specified accesspoint does not exist</Message></Error></ErrorResponse>`,
+			},
+		},
+		"access denied": {
+			response: fakeResponse{
+				statusCode: http.StatusForbidden,
+				body:       `<ErrorResponse><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error></ErrorResponse>`,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newFakeClient(testCase.response)
+
+			_, state, err := tfs3control.StatusAccessPointAlias(context.Background(), conn, accountID, "example")()
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if state != testCase.expectedState {
+				t.Errorf("state = %q, want %q", state, testCase.expectedState)
+			}
+		})
+	}
+}
+
+func TestValidateAccessPointVPCConfigurationChange(t *testing.T) {
+	t.Parallel()
+
+	const (
+		outpostsBucketARN = "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example" //lintignore:AWSAT003,AWSAT005
+	)
+	vpcConfiguration := []interface{}{map[string]interface{}{"vpc_id": "vpc-12345678"}}
+
+	testCases := map[string]struct {
+		bucket      string
+		old, new    []interface{}
+		expectError bool
+	}{
+		"Outposts removed": {
+			bucket:      outpostsBucketARN,
+			old:         vpcConfiguration,
+			expectError: true,
+		},
+		"Outposts added": {
+			bucket: outpostsBucketARN,
+			new:    vpcConfiguration,
+		},
+		"Outposts changed": {
+			bucket: outpostsBucketARN,
+			old:    vpcConfiguration,
+			new:    []interface{}{map[string]interface{}{"vpc_id": "vpc-87654321"}},
+		},
+		"bucket name removed": {
+			bucket: "example",
+			old:    vpcConfiguration,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3control.ValidateAccessPointVPCConfigurationChange(testCase.bucket, testCase.old, testCase.new)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("got error %v, want error: %t", err, want)
+			}
+		})
+	}
+}
+
 func testAccCheckAccessPointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -447,6 +680,26 @@ resource "aws_s3_access_point" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_bucketARNNoVPC(rName string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3control_bucket.test.arn
+  name   = %[1]q
+}
+`, rName)
+}
+
 func testAccAccessPointConfig_policy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -578,6 +831,58 @@ resource "aws_s3_access_point" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_publicBlockPolicyOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  public_access_block_configuration {
+    block_public_policy = false
+  }
+}
+`, rName)
+}
+
+func testAccAccessPointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAccessPointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
 func testAccAccessPointConfig_vpc(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
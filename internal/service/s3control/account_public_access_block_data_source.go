@@ -21,6 +21,7 @@ func dataSourceAccountPublicAccessBlock() *schema.Resource {
 			"account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: verify.ValidAccountID,
 			},
 			"block_public_acls": {
@@ -58,6 +59,7 @@ func dataSourceAccountPublicAccessBlockRead(ctx context.Context, d *schema.Resou
 	}
 
 	d.SetId(accountID)
+	d.Set("account_id", accountID)
 	d.Set("block_public_acls", output.BlockPublicAcls)
 	d.Set("block_public_policy", output.BlockPublicPolicy)
 	d.Set("ignore_public_acls", output.IgnorePublicAcls)
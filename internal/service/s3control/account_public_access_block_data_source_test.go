@@ -6,6 +6,7 @@ package s3control_test
 import (
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -34,6 +35,36 @@ func testAccAccountPublicAccessBlockDataSource_basic(t *testing.T) {
 	})
 }
 
+func testAccAccountPublicAccessBlockDataSource_accountID(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_account_public_access_block.test"
+	dataSourceName := "data.aws_s3_account_public_access_block.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAlternateAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPublicAccessBlockDataSourceConfig_accountID(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "block_public_acls", dataSourceName, "block_public_acls"),
+					resource.TestCheckResourceAttrPair(resourceName, "block_public_policy", dataSourceName, "block_public_policy"),
+					resource.TestCheckResourceAttrPair(resourceName, "ignore_public_acls", dataSourceName, "ignore_public_acls"),
+					resource.TestCheckResourceAttrPair(resourceName, "restrict_public_buckets", dataSourceName, "restrict_public_buckets"),
+				),
+			},
+			// The caller can't read another account's settings, so the request must be denied.
+			{
+				Config:      testAccAccountPublicAccessBlockDataSourceConfig_alternateAccountID(),
+				ExpectError: regexache.MustCompile(`AccessDenied`),
+			},
+		},
+	})
+}
+
 func testAccAccountPublicAccessBlockDataSourceConfig_base() string {
 	return `
 resource "aws_s3_account_public_access_block" "test" {
@@ -52,3 +83,29 @@ data "aws_s3_account_public_access_block" "test" {
 }
 `)
 }
+
+func testAccAccountPublicAccessBlockDataSourceConfig_accountID() string {
+	return acctest.ConfigCompose(testAccAccountPublicAccessBlockDataSourceConfig_base(), `
+data "aws_caller_identity" "current" {}
+
+data "aws_s3_account_public_access_block" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_s3_account_public_access_block.test]
+}
+`)
+}
+
+func testAccAccountPublicAccessBlockDataSourceConfig_alternateAccountID() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccAccountPublicAccessBlockDataSourceConfig_base(), `
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_s3_account_public_access_block" "test" {
+  account_id = data.aws_caller_identity.alternate.account_id
+
+  depends_on = [aws_s3_account_public_access_block.test]
+}
+`)
+}
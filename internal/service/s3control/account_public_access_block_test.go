@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -28,12 +30,17 @@ func TestAccS3ControlAccountPublicAccessBlock_serial(t *testing.T) {
 		"PublicAccessBlock": {
 			"basic":                 testAccAccountPublicAccessBlock_basic,
 			"disappears":            testAccAccountPublicAccessBlock_disappears,
+			"drift":                 testAccAccountPublicAccessBlock_drift,
 			"AccountId":             testAccAccountPublicAccessBlock_AccountID,
 			"BlockPublicAcls":       testAccAccountPublicAccessBlock_BlockPublicACLs,
 			"BlockPublicPolicy":     testAccAccountPublicAccessBlock_BlockPublicPolicy,
 			"IgnorePublicAcls":      testAccAccountPublicAccessBlock_IgnorePublicACLs,
 			"RestrictPublicBuckets": testAccAccountPublicAccessBlock_RestrictPublicBuckets,
 			"DataSourceBasic":       testAccAccountPublicAccessBlockDataSource_basic,
+			"DataSourceAccountId":   testAccAccountPublicAccessBlockDataSource_accountID,
+		},
+		"MultiRegionAccessPoint": {
+			"AccountPublicAccessBlock": testAccMultiRegionAccessPoint_accountPublicAccessBlock,
 		},
 	}
 
@@ -94,6 +101,52 @@ func testAccAccountPublicAccessBlock_disappears(t *testing.T) {
 	})
 }
 
+func testAccAccountPublicAccessBlock_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.PublicAccessBlockConfiguration
+	resourceName := "aws_s3_account_public_access_block.test"
+
+	// Each step enables a single flag out-of-band, which the next step's apply reverts.
+	var steps []resource.TestStep
+	for _, cfg := range []types.PublicAccessBlockConfiguration{
+		{BlockPublicAcls: aws.Bool(true)},
+		{BlockPublicPolicy: aws.Bool(true)},
+		{IgnorePublicAcls: aws.Bool(true)},
+		{RestrictPublicBuckets: aws.Bool(true)},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config: testAccAccountPublicAccessBlockConfig_basic(),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckAccountPublicAccessBlockExists(ctx, resourceName, &v),
+				resource.TestCheckResourceAttr(resourceName, "block_public_acls", "false"),
+				resource.TestCheckResourceAttr(resourceName, "block_public_policy", "false"),
+				resource.TestCheckResourceAttr(resourceName, "ignore_public_acls", "false"),
+				resource.TestCheckResourceAttr(resourceName, "restrict_public_buckets", "false"),
+				testAccCheckAccountPublicAccessBlockUpdate(ctx, resourceName, cfg),
+			),
+			ExpectNonEmptyPlan: true,
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccAccountPublicAccessBlockConfig_basic(),
+		Check: resource.ComposeTestCheckFunc(
+			testAccCheckAccountPublicAccessBlockExists(ctx, resourceName, &v),
+			resource.TestCheckResourceAttr(resourceName, "block_public_acls", "false"),
+			resource.TestCheckResourceAttr(resourceName, "block_public_policy", "false"),
+			resource.TestCheckResourceAttr(resourceName, "ignore_public_acls", "false"),
+			resource.TestCheckResourceAttr(resourceName, "restrict_public_buckets", "false"),
+		),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPublicAccessBlockDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
 func testAccAccountPublicAccessBlock_AccountID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.PublicAccessBlockConfiguration
@@ -306,6 +359,38 @@ func testAccCheckAccountPublicAccessBlockExists(ctx context.Context, n string, v
 	}
 }
 
+func testAccCheckAccountPublicAccessBlockUpdate(ctx context.Context, n string, cfg types.PublicAccessBlockConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		// Unset flags are sent as false, matching the API's own defaults.
+		target := &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(aws.ToBool(cfg.BlockPublicAcls)),
+			BlockPublicPolicy:     aws.Bool(aws.ToBool(cfg.BlockPublicPolicy)),
+			IgnorePublicAcls:      aws.Bool(aws.ToBool(cfg.IgnorePublicAcls)),
+			RestrictPublicBuckets: aws.Bool(aws.ToBool(cfg.RestrictPublicBuckets)),
+		}
+
+		_, err := conn.PutPublicAccessBlock(ctx, &s3control.PutPublicAccessBlockInput{
+			AccountId:                      aws.String(rs.Primary.ID),
+			PublicAccessBlockConfiguration: target,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3control.WaitPublicAccessBlockEqual(ctx, conn, rs.Primary.ID, target)
+
+		return err
+	}
+}
+
 func testAccCheckAccountPublicAccessBlockDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
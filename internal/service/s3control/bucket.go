@@ -13,14 +13,19 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -65,6 +70,11 @@ func resourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"outpost_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -92,17 +102,20 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		OutpostId: aws.String(d.Get("outpost_id").(string)),
 	}
 
-	output, err := conn.CreateBucket(ctx, input)
+	output, err := retryWhenThrottled(ctx, d.Timeout(schema.TimeoutCreate), func() (*s3control.CreateBucketOutput, error) {
+		return conn.CreateBucket(ctx, input)
+	})
 
 	if err != nil {
-		return diag.Errorf("creating S3 Control Bucket (%s): %s", bucket, err)
+		return create.DiagErrorfWithCode(err, "creating S3 Control Bucket (%s): %s", bucket, withHTTPStatusCode(err))
 	}
 
+	// Set the ID before tagging so that a tagging failure leaves the bucket tracked (tainted) in state.
 	d.SetId(aws.ToString(output.BucketArn))
 
 	if tags := keyValueTagsS3(ctx, getTagsInS3(ctx)); len(tags) > 0 {
 		if err := bucketUpdateTags(ctx, conn, d.Id(), nil, tags); err != nil {
-			return diag.Errorf("adding S3 Control Bucket (%s) tags: %s", d.Id(), err)
+			return create.DiagErrorfWithCode(err, "adding S3 Control Bucket (%s) tags: %s", d.Id(), withHTTPStatusCode(err))
 		}
 	}
 
@@ -125,6 +138,10 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", d.Id())
 	}
 
+	if err := validateBucketARNPartitionAndRegion(parsedArn, meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region); err != nil {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): %s", d.Id(), err)
+	}
+
 	output, err := findBucketByTwoPartKey(ctx, conn, parsedArn.AccountID, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -134,7 +151,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if err != nil {
-		return diag.Errorf("reading S3 Control Bucket (%s): %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "reading S3 Control Bucket (%s): %s", d.Id(), withHTTPStatusCode(err))
 	}
 
 	d.Set("arn", d.Id())
@@ -148,7 +165,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	tags, err := bucketListTags(ctx, conn, d.Id())
 
 	if err != nil {
-		return diag.Errorf("listing tags for S3 Control Bucket (%s): %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "listing tags for S3 Control Bucket (%s): %s", d.Id(), withHTTPStatusCode(err))
 	}
 
 	setTagsOutS3(ctx, tagsS3(tags))
@@ -163,7 +180,7 @@ func resourceBucketUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		o, n := d.GetChange("tags_all")
 
 		if err := bucketUpdateTags(ctx, conn, d.Id(), o, n); err != nil {
-			return diag.Errorf("updating S3 Control Bucket (%s) tags: %s", d.Id(), err)
+			return create.DiagErrorfWithCode(err, "updating S3 Control Bucket (%s) tags: %s", d.Id(), withHTTPStatusCode(err))
 		}
 	}
 
@@ -179,6 +196,17 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if d.Get("force_destroy").(bool) {
+		// Objects in an S3 on Outposts bucket can only be accessed via one of the bucket's access points.
+		n, err := emptyBucket(ctx, conn, meta.(*conns.AWSClient).S3Client(ctx), meta.(*conns.AWSClient).S3OutpostsConn(ctx), parsedArn.AccountID, d.Id(), d.Get("outpost_id").(string))
+
+		if err != nil {
+			return create.DiagErrorfWithCode(err, "emptying S3 Control Bucket (%s): %s", d.Id(), withHTTPStatusCode(err))
+		}
+
+		log.Printf("[DEBUG] Deleted %d S3 on Outposts objects and multipart uploads", n)
+	}
+
 	input := &s3control.DeleteBucketInput{
 		AccountId: aws.String(parsedArn.AccountID),
 		Bucket:    aws.String(d.Id()),
@@ -188,7 +216,7 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	// can occur on deletion:
 	//   InvalidBucketState: Bucket is in an invalid state
 	log.Printf("[DEBUG] Deleting S3 Control Bucket: %s", d.Id())
-	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketStatePropagationTimeout, func() (interface{}, error) {
+	_, err = retryWhenThrottledOrAWSErrCodeEquals(ctx, bucketStatePropagationTimeout, func() (*s3control.DeleteBucketOutput, error) {
 		return conn.DeleteBucket(ctx, input)
 	}, errCodeInvalidBucketState)
 
@@ -197,7 +225,7 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if err != nil {
-		return diag.Errorf("deleting S3 Control Bucket (%s): %s", d.Id(), err)
+		return create.DiagErrorfWithCode(err, "deleting S3 Control Bucket (%s): %s", d.Id(), withHTTPStatusCode(err))
 	}
 
 	return nil
@@ -229,6 +257,201 @@ func findBucketByTwoPartKey(ctx context.Context, conn *s3control.Client, account
 	return output, nil
 }
 
+// emptyBucket empties the specified S3 on Outposts bucket by aborting all multipart uploads and
+// deleting all object versions and delete markers via the first of the bucket's access points.
+// If the bucket has no access points, e.g. because they were destroyed before the bucket,
+// a temporary access point is created in the VPC of one of the Outpost's S3 endpoints.
+// Returns the number of multipart uploads, object versions and delete markers deleted.
+func emptyBucket(ctx context.Context, conn *s3control.Client, s3Conn *s3.Client, s3OutpostsConn *s3outposts.S3Outposts, accountID, bucket, outpostID string) (int64, error) {
+	accessPointARNs, err := findAccessPointARNsByBucket(ctx, conn, accountID, bucket)
+
+	if err != nil {
+		return 0, fmt.Errorf("listing access points: %w", err)
+	}
+
+	var accessPointARN string
+
+	if len(accessPointARNs) > 0 {
+		accessPointARN = accessPointARNs[0]
+	} else {
+		vpcID, err := findOutpostEndpointVPCID(ctx, s3OutpostsConn, outpostID)
+
+		if err != nil {
+			return 0, fmt.Errorf("creating temporary access point: %w", err)
+		}
+
+		name := id.PrefixedUniqueId("tf-")
+		output, err := conn.CreateAccessPoint(ctx, &s3control.CreateAccessPointInput{
+			AccountId: aws.String(accountID),
+			Bucket:    aws.String(bucket),
+			Name:      aws.String(name),
+			VpcConfiguration: &types.VpcConfiguration{
+				VpcId: aws.String(vpcID),
+			},
+		})
+
+		if err != nil {
+			return 0, fmt.Errorf("creating temporary access point (%s): %w", name, err)
+		}
+
+		accessPointARN = aws.ToString(output.AccessPointArn)
+
+		defer func() {
+			_, err := conn.DeleteAccessPoint(ctx, &s3control.DeleteAccessPointInput{
+				AccountId: aws.String(accountID),
+				Name:      aws.String(accessPointARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, errCodeNoSuchAccessPoint) {
+				log.Printf("[WARN] deleting temporary S3 Access Point (%s): %s", accessPointARN, err)
+			}
+		}()
+	}
+
+	var nObjects int64
+
+	uploads := s3.NewListMultipartUploadsPaginator(s3Conn, &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(accessPointARN),
+	})
+	for uploads.HasMorePages() {
+		page, err := uploads.NextPage(ctx)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("listing multipart uploads (%s): %w", accessPointARN, err)
+		}
+
+		for _, v := range page.Uploads {
+			input := &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(accessPointARN),
+				Key:      v.Key,
+				UploadId: v.UploadId,
+			}
+
+			_, err := s3Conn.AbortMultipartUpload(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchUpload) {
+				continue
+			}
+
+			if err != nil {
+				return nObjects, fmt.Errorf("aborting multipart upload (%s): %w", aws.ToString(v.UploadId), err)
+			}
+
+			nObjects++
+		}
+	}
+
+	versions := s3.NewListObjectVersionsPaginator(s3Conn, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(accessPointARN),
+	})
+	for versions.HasMorePages() {
+		page, err := versions.NextPage(ctx)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("listing object versions (%s): %w", accessPointARN, err)
+		}
+
+		var toDelete []s3types.ObjectIdentifier
+		for _, v := range page.Versions {
+			toDelete = append(toDelete, s3types.ObjectIdentifier{
+				Key:       v.Key,
+				VersionId: v.VersionId,
+			})
+		}
+		for _, v := range page.DeleteMarkers {
+			toDelete = append(toDelete, s3types.ObjectIdentifier{
+				Key:       v.Key,
+				VersionId: v.VersionId,
+			})
+		}
+
+		if len(toDelete) == 0 {
+			continue
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(accessPointARN),
+			Delete: &s3types.Delete{
+				Objects: toDelete,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := s3Conn.DeleteObjects(ctx, input)
+
+		if err != nil {
+			return nObjects, fmt.Errorf("deleting objects (%s): %w", accessPointARN, err)
+		}
+
+		if len(output.Errors) > 0 {
+			v := output.Errors[0]
+			return nObjects, fmt.Errorf("deleting object (%s) version (%s): %s: %s", aws.ToString(v.Key), aws.ToString(v.VersionId), aws.ToString(v.Code), aws.ToString(v.Message))
+		}
+
+		nObjects += int64(len(toDelete))
+	}
+
+	return nObjects, nil
+}
+
+// findAccessPointARNsByBucket returns the ARNs of the access points of the specified S3 on Outposts bucket.
+func findAccessPointARNsByBucket(ctx context.Context, conn *s3control.Client, accountID, bucket string) ([]string, error) {
+	input := &s3control.ListAccessPointsInput{
+		AccountId: aws.String(accountID),
+		Bucket:    aws.String(bucket),
+	}
+	var output []string
+
+	pages := s3control.NewListAccessPointsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchOutpost) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AccessPointList {
+			output = append(output, aws.ToString(v.AccessPointArn))
+		}
+	}
+
+	return output, nil
+}
+
+// findOutpostEndpointVPCID returns the ID of the VPC of an available S3 on Outposts endpoint in the specified Outpost.
+func findOutpostEndpointVPCID(ctx context.Context, conn *s3outposts.S3Outposts, outpostID string) (string, error) {
+	var vpcID string
+
+	err := conn.ListEndpointsPagesWithContext(ctx, &s3outposts.ListEndpointsInput{}, func(page *s3outposts.ListEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Endpoints {
+			if aws.ToString(v.OutpostsId) == outpostID && aws.ToString(v.Status) == s3outposts.EndpointStatusAvailable && aws.ToString(v.VpcId) != "" {
+				vpcID = aws.ToString(v.VpcId)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("listing S3 on Outposts endpoints: %w", err)
+	}
+
+	if vpcID == "" {
+		return "", fmt.Errorf("no available S3 on Outposts endpoint found in Outpost (%s)", outpostID)
+	}
+
+	return vpcID, nil
+}
+
 // Custom S3control tagging functions using similar formatting as other service generated code.
 
 // bucketListTags lists S3control bucket tags.
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3control_bucket", name="Bucket")
+func dataSourceBucket() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bucketARN := d.Get("arn").(string)
+	parsedArn, err := arn.Parse(bucketARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ARN resource format: outpost/<outpost-id>/bucket/<my-bucket-name>
+	arnResourceParts := strings.Split(parsedArn.Resource, "/")
+
+	if parsedArn.AccountID == "" || len(arnResourceParts) != 4 {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", bucketARN)
+	}
+
+	output, err := findBucketByTwoPartKey(ctx, conn, parsedArn.AccountID, bucketARN)
+
+	if err != nil {
+		return create.DiagErrorfWithCode(err, "reading S3 Control Bucket (%s): %s", bucketARN, withHTTPStatusCode(err))
+	}
+
+	d.SetId(bucketARN)
+	d.Set("bucket", output.Bucket)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("outpost_id", arnResourceParts[1])
+	d.Set("public_access_block_enabled", output.PublicAccessBlockEnabled)
+
+	tags, err := bucketListTags(ctx, conn, bucketARN)
+
+	if err != nil {
+		return create.DiagErrorfWithCode(err, "listing tags for S3 Control Bucket (%s): %s", bucketARN, withHTTPStatusCode(err))
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	return nil
+}
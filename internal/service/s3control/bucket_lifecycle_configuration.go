@@ -5,6 +5,7 @@ package s3control
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -56,8 +57,9 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"days_after_initiation": {
-										Type:     schema.TypeInt,
-										Required: true,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
 									},
 								},
 							},
@@ -101,6 +103,16 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
 									"prefix": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -123,7 +135,45 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+	}
+}
+
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var errs []error
+
+	for _, tfMapRaw := range d.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if err := validateLifecycleRuleFilterObjectSize(v[0].(map[string]interface{})); err != nil {
+				errs = append(errs, fmt.Errorf("rule (%s): %w", tfMap["id"], err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateLifecycleRuleFilterObjectSize checks that a filter's object size range isn't empty.
+// Unset (or not yet known) bounds are zero and aren't checked.
+func validateLifecycleRuleFilterObjectSize(tfMap map[string]interface{}) error {
+	greaterThan, _ := tfMap["object_size_greater_than"].(int)
+	lessThan, _ := tfMap["object_size_less_than"].(int)
+
+	if greaterThan == 0 || lessThan == 0 {
+		return nil
 	}
+
+	if greaterThan >= lessThan {
+		return fmt.Errorf("filter object_size_greater_than (%d) must be less than object_size_less_than (%d)", greaterThan, lessThan)
+	}
+
+	return nil
 }
 
 func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -410,8 +460,9 @@ func expandLifecycleRuleFilter(ctx context.Context, tfList []interface{}) *types
 	}
 
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
-		// See also aws_s3_bucket ReplicationRule.Filter handling
-		if len(v) == 1 {
+		// See also aws_s3_bucket ReplicationRule.Filter handling.
+		// A tag combined with a prefix must be specified via And.
+		if len(v) == 1 && apiObject.Prefix == nil {
 			apiObject.Tag = &tagsS3(tftags.New(ctx, v))[0]
 		} else {
 			apiObject.And = &types.LifecycleRuleAndOperator{
@@ -422,6 +473,40 @@ func expandLifecycleRuleFilter(ctx context.Context, tfList []interface{}) *types
 		}
 	}
 
+	var greaterThan, lessThan *int64
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v != 0 {
+		greaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v != 0 {
+		lessThan = aws.Int64(int64(v))
+	}
+
+	if greaterThan == nil && lessThan == nil {
+		return apiObject
+	}
+
+	// Object size bounds combined with any other condition must be specified via And.
+	if apiObject.And == nil && (apiObject.Prefix != nil || apiObject.Tag != nil || (greaterThan != nil && lessThan != nil)) {
+		apiObject.And = &types.LifecycleRuleAndOperator{
+			Prefix: apiObject.Prefix,
+		}
+		if apiObject.Tag != nil {
+			apiObject.And.Tags = []types.S3Tag{*apiObject.Tag}
+		}
+		apiObject.Prefix = nil
+		apiObject.Tag = nil
+	}
+
+	if apiObject.And != nil {
+		apiObject.And.ObjectSizeGreaterThan = greaterThan
+		apiObject.And.ObjectSizeLessThan = lessThan
+	} else {
+		apiObject.ObjectSizeGreaterThan = greaterThan
+		apiObject.ObjectSizeLessThan = lessThan
+	}
+
 	return apiObject
 }
 
@@ -496,6 +581,14 @@ func flattenLifecycleRuleFilter(ctx context.Context, apiObject *types.LifecycleR
 	tfMap := map[string]interface{}{}
 
 	if apiObject.And != nil {
+		if v := apiObject.And.ObjectSizeGreaterThan; v != nil {
+			tfMap["object_size_greater_than"] = aws.ToInt64(v)
+		}
+
+		if v := apiObject.And.ObjectSizeLessThan; v != nil {
+			tfMap["object_size_less_than"] = aws.ToInt64(v)
+		}
+
 		if v := apiObject.And.Prefix; v != nil {
 			tfMap["prefix"] = aws.ToString(v)
 		}
@@ -504,6 +597,14 @@ func flattenLifecycleRuleFilter(ctx context.Context, apiObject *types.LifecycleR
 			tfMap["tags"] = keyValueTagsS3(ctx, v).IgnoreAWS().Map()
 		}
 	} else {
+		if v := apiObject.ObjectSizeGreaterThan; v != nil {
+			tfMap["object_size_greater_than"] = aws.ToInt64(v)
+		}
+
+		if v := apiObject.ObjectSizeLessThan; v != nil {
+			tfMap["object_size_less_than"] = aws.ToInt64(v)
+		}
+
 		if v := apiObject.Prefix; v != nil {
 			tfMap["prefix"] = aws.ToString(v)
 		}
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -21,6 +23,86 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestExpandLifecycleRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		tfMap          map[string]interface{}
+		expectAnd      bool
+		expectedPrefix string
+		expectedTags   int
+	}{
+		"prefix": {
+			tfMap:          map[string]interface{}{"prefix": "logs/"},
+			expectedPrefix: "logs/",
+		},
+		"one tag": {
+			tfMap:        map[string]interface{}{"tags": map[string]interface{}{"key1": "value1"}},
+			expectedTags: 1,
+		},
+		"two tags": {
+			tfMap:        map[string]interface{}{"tags": map[string]interface{}{"key1": "value1", "key2": "value2"}},
+			expectAnd:    true,
+			expectedTags: 2,
+		},
+		"prefix and one tag": {
+			tfMap:          map[string]interface{}{"prefix": "logs/", "tags": map[string]interface{}{"key1": "value1"}},
+			expectAnd:      true,
+			expectedPrefix: "logs/",
+			expectedTags:   1,
+		},
+		"prefix and two tags": {
+			tfMap:          map[string]interface{}{"prefix": "logs/", "tags": map[string]interface{}{"key1": "value1", "key2": "value2"}},
+			expectAnd:      true,
+			expectedPrefix: "logs/",
+			expectedTags:   2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := tfs3control.ExpandLifecycleRuleFilter(ctx, []interface{}{testCase.tfMap})
+
+			if !testCase.expectAnd {
+				if apiObject.And != nil {
+					t.Fatalf("unexpected And: %+v", apiObject.And)
+				}
+
+				if got := aws.ToString(apiObject.Prefix); got != testCase.expectedPrefix {
+					t.Errorf("Prefix = %q, want %q", got, testCase.expectedPrefix)
+				}
+
+				if got := apiObject.Tag != nil; got != (testCase.expectedTags == 1) {
+					t.Errorf("Tag = %+v, want %d tag(s)", apiObject.Tag, testCase.expectedTags)
+				}
+
+				return
+			}
+
+			if apiObject.And == nil {
+				t.Fatal("expected And")
+			}
+
+			if apiObject.Prefix != nil || apiObject.Tag != nil {
+				t.Errorf("Prefix and Tag must be unset when And is set, got %q, %+v", aws.ToString(apiObject.Prefix), apiObject.Tag)
+			}
+
+			if got := aws.ToString(apiObject.And.Prefix); got != testCase.expectedPrefix {
+				t.Errorf("And.Prefix = %q, want %q", got, testCase.expectedPrefix)
+			}
+
+			if got := len(apiObject.And.Tags); got != testCase.expectedTags {
+				t.Errorf("len(And.Tags) = %d, want %d", got, testCase.expectedTags)
+			}
+		})
+	}
+}
+
 func TestAccS3ControlBucketLifecycleConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -291,6 +373,43 @@ func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_prefix(t *testing.T
 	})
 }
 
+func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_objectSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName, 2048, 1024),
+				ExpectError: regexache.MustCompile(`object_size_greater_than \(2048\) must be less than object_size_less_than \(1024\)`),
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName, 1024, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":                          "1",
+						"filter.0.object_size_greater_than": "1024",
+						"filter.0.object_size_less_than":    "2048",
+						"filter.0.prefix":                   "test/",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -319,22 +438,19 @@ func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_tags(t *testing.T)
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
-			// There is currently an API model or AWS Go SDK bug where LifecycleFilter.And.Tags
-			// does not get populated from the XML response. Reference:
-			// https://github.com/aws/aws-sdk-go/issues/3591
-			// {
-			// 	Config: testAccBucketLifecycleConfigurationConfig_ruleFilterTags2(rName, "key1", "value1updated", "key2", "value2"),
-			// 	Check: resource.ComposeTestCheckFunc(
-			// 		testAccCheckBucketLifecycleConfigurationExists(resourceName),
-			// 		resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
-			// 		resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
-			// 			"filter.#":           "1",
-			// 			"filter.0.tags.%":    "2",
-			// 			"filter.0.tags.key1": "value1updated",
-			// 			"filter.0.tags.key2": "value2",
-			// 		}),
-			// 	),
-			// },
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":           "1",
+						"filter.0.tags.%":    "2",
+						"filter.0.tags.key1": "value1updated",
+						"filter.0.tags.key2": "value2",
+					}),
+				),
+			},
 			{
 				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterTags1(rName, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
@@ -351,6 +467,40 @@ func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_tags(t *testing.T)
 	})
 }
 
+func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_prefixAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterPrefixAndTags(rName, "logs/", "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":           "1",
+						"filter.0.prefix":    "logs/",
+						"filter.0.tags.%":    "2",
+						"filter.0.tags.key1": "value1",
+						"filter.0.tags.key2": "value2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3ControlBucketLifecycleConfiguration_Rule_id(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -626,6 +776,39 @@ resource "aws_s3control_bucket_lifecycle_configuration" "test" {
 `, rName, prefix)
 }
 
+func testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName string, greaterThan, lessThan int) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    expiration {
+      days = 365
+    }
+
+    filter {
+      object_size_greater_than = %[2]d
+      object_size_less_than    = %[3]d
+      prefix                   = "test/"
+    }
+
+    id = "test"
+  }
+}
+`, rName, greaterThan, lessThan)
+}
+
 func testAccBucketLifecycleConfigurationConfig_ruleFilterTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
@@ -659,40 +842,75 @@ resource "aws_s3control_bucket_lifecycle_configuration" "test" {
 `, rName, tagKey1, tagValue1)
 }
 
-// See TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_tags note about XML handling bug.
-// func testAccBucketLifecycleConfigurationConfig_ruleFilterTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
-// 	return fmt.Sprintf(`
-// data "aws_outposts_outposts" "test" {}
-
-// data "aws_outposts_outpost" "test" {
-//   id = tolist(data.aws_outposts_outposts.test.ids)[0]
-// }
-
-// resource "aws_s3control_bucket" "test" {
-//   bucket     = %[1]q
-//   outpost_id = data.aws_outposts_outpost.test.id
-// }
-
-// resource "aws_s3control_bucket_lifecycle_configuration" "test" {
-//   bucket = aws_s3control_bucket.test.arn
-
-//   rule {
-//     expiration {
-//       days = 365
-//     }
-
-//     filter {
-//       tags = {
-//         %[2]q = %[3]q
-//         %[4]q = %[5]q
-//       }
-//     }
-
-//     id = "test"
-//   }
-// }
-// `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
-// }
+func testAccBucketLifecycleConfigurationConfig_ruleFilterTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    expiration {
+      days = 365
+    }
+
+    filter {
+      tags = {
+        %[2]q = %[3]q
+        %[4]q = %[5]q
+      }
+    }
+
+    id = "test"
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccBucketLifecycleConfigurationConfig_ruleFilterPrefixAndTags(rName, prefix, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    expiration {
+      days = 365
+    }
+
+    filter {
+      prefix = %[2]q
+
+      tags = {
+        %[3]q = %[4]q
+        %[5]q = %[6]q
+      }
+    }
+
+    id = "test"
+  }
+}
+`, rName, prefix, tagKey1, tagValue1, tagKey2, tagValue2)
+}
 
 func testAccBucketLifecycleConfigurationConfig_ruleID(rName, id string) string {
 	return fmt.Sprintf(`
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_s3control_bucket_replication_configuration", name="Bucket Replication Configuration")
+func resourceBucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketReplicationConfigurationCreate,
+		ReadWithoutTimeout:   resourceBucketReplicationConfigurationRead,
+		UpdateWithoutTimeout: resourceBucketReplicationConfigurationUpdate,
+		DeleteWithoutTimeout: resourceBucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"delete_marker_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.DeleteMarkerReplicationStatus](),
+									},
+								},
+							},
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.MetricsStatus](),
+												},
+											},
+										},
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          string(types.ReplicationStorageClassOutposts),
+										ValidateDiagFunc: enum.Validate[types.ReplicationStorageClass](),
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tags": tftags.TagsSchema(),
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"status": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ReplicationRuleStatus](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	bucket := d.Get("bucket").(string)
+
+	parsedArn, err := arn.Parse(bucket)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parsedArn.AccountID == "" {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", bucket)
+	}
+
+	input := &s3control.PutBucketReplicationInput{
+		AccountId: aws.String(parsedArn.AccountID),
+		Bucket:    aws.String(bucket),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandReplicationRules(ctx, d.Get("rule").([]interface{})),
+		},
+	}
+
+	_, err = conn.PutBucketReplication(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Control Bucket Replication Configuration (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketReplicationConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parsedArn, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parsedArn.AccountID == "" {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", d.Id())
+	}
+
+	output, err := findBucketReplicationConfigurationByTwoPartKey(ctx, conn, parsedArn.AccountID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Control Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Control Bucket Replication Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", output.Role)
+	if err := d.Set("rule", flattenReplicationRules(ctx, output.Rules)); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parsedArn, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parsedArn.AccountID == "" {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", d.Id())
+	}
+
+	input := &s3control.PutBucketReplicationInput{
+		AccountId: aws.String(parsedArn.AccountID),
+		Bucket:    aws.String(d.Id()),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandReplicationRules(ctx, d.Get("rule").([]interface{})),
+		},
+	}
+
+	_, err = conn.PutBucketReplication(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating S3 Control Bucket Replication Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceBucketReplicationConfigurationRead(ctx, d, meta)
+}
+
+func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parsedArn, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parsedArn.AccountID == "" {
+		return diag.Errorf("parsing S3 Control Bucket ARN (%s): unknown format", d.Id())
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Control Bucket Replication Configuration: %s", d.Id())
+	_, err = conn.DeleteBucketReplication(ctx, &s3control.DeleteBucketReplicationInput{
+		AccountId: aws.String(parsedArn.AccountID),
+		Bucket:    aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchOutpost, errCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Control Bucket Replication Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findBucketReplicationConfigurationByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, bucket string) (*types.ReplicationConfiguration, error) {
+	input := &s3control.GetBucketReplicationInput{
+		AccountId: aws.String(accountID),
+		Bucket:    aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketReplication(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchOutpost, errCodeReplicationConfigurationNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReplicationConfiguration, nil
+}
+
+func expandReplicationRules(ctx context.Context, tfList []interface{}) []types.ReplicationRule {
+	var apiObjects []types.ReplicationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandReplicationRule(ctx, tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandReplicationRule(ctx context.Context, tfMap map[string]interface{}) types.ReplicationRule {
+	apiObject := types.ReplicationRule{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["delete_marker_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeleteMarkerReplication = &types.DeleteMarkerReplication{
+			Status: types.DeleteMarkerReplicationStatus(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandReplicationDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandReplicationRuleFilter(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["priority"].(int); ok && v != 0 {
+		apiObject.Priority = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = types.ReplicationRuleStatus(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicationDestination(tfMap map[string]interface{}) *types.Destination {
+	apiObject := &types.Destination{}
+
+	if v, ok := tfMap["account"].(string); ok && v != "" {
+		apiObject.Account = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Metrics = &types.Metrics{
+			Status: types.MetricsStatus(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = types.ReplicationStorageClass(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicationRuleFilter(ctx context.Context, tfMap map[string]interface{}) *types.ReplicationRuleFilter {
+	apiObject := &types.ReplicationRuleFilter{}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		// See also aws_s3control_bucket_lifecycle_configuration LifecycleRuleFilter handling.
+		if len(v) == 1 && apiObject.Prefix == nil {
+			apiObject.Tag = &tagsS3(tftags.New(ctx, v))[0]
+		} else {
+			apiObject.And = &types.ReplicationRuleAndOperator{
+				Prefix: apiObject.Prefix,
+				Tags:   tagsS3(tftags.New(ctx, v)),
+			}
+			apiObject.Prefix = nil
+		}
+	}
+
+	return apiObject
+}
+
+func flattenReplicationRules(ctx context.Context, apiObjects []types.ReplicationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenReplicationRule(ctx, apiObject))
+	}
+
+	return tfList
+}
+
+func flattenReplicationRule(ctx context.Context, apiObject types.ReplicationRule) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"bucket":   aws.ToString(apiObject.Bucket),
+		"id":       aws.ToString(apiObject.ID),
+		"priority": aws.ToInt32(apiObject.Priority),
+		"status":   string(apiObject.Status),
+	}
+
+	if v := apiObject.DeleteMarkerReplication; v != nil {
+		tfMap["delete_marker_replication"] = []interface{}{map[string]interface{}{
+			"status": string(v.Status),
+		}}
+	}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = flattenReplicationDestination(v)
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = flattenReplicationRuleFilter(ctx, v)
+	}
+
+	return tfMap
+}
+
+func flattenReplicationDestination(apiObject *types.Destination) []interface{} {
+	tfMap := map[string]interface{}{
+		"account":       aws.ToString(apiObject.Account),
+		"bucket":        aws.ToString(apiObject.Bucket),
+		"storage_class": string(apiObject.StorageClass),
+	}
+
+	if v := apiObject.Metrics; v != nil {
+		tfMap["metrics"] = []interface{}{map[string]interface{}{
+			"status": string(v.Status),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReplicationRuleFilter(ctx context.Context, apiObject *types.ReplicationRuleFilter) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if apiObject.And != nil {
+		if v := apiObject.And.Prefix; v != nil {
+			tfMap["prefix"] = aws.ToString(v)
+		}
+
+		if v := apiObject.And.Tags; v != nil {
+			tfMap["tags"] = keyValueTagsS3(ctx, v).IgnoreAWS().Map()
+		}
+	} else {
+		if v := apiObject.Prefix; v != nil {
+			tfMap["prefix"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Tag; v != nil {
+			tfMap["tags"] = keyValueTagsS3(ctx, []types.S3Tag{*v}).IgnoreAWS().Map()
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlBucketReplicationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_bucket_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Replication requires versioning on both buckets, which is enabled
+			// outside of Terraform before the replication configuration is created.
+			{
+				Config: testAccBucketReplicationConfigurationConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketEnableVersioning(ctx, "aws_s3control_bucket.source"),
+					testAccCheckBucketEnableVersioning(ctx, "aws_s3control_bucket.destination"),
+				),
+			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_prefix(rName, "test1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3control_bucket.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.bucket", "aws_s3_access_point.source", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.delete_marker_replication.0.status", string(types.DeleteMarkerReplicationStatusDisabled)),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.destination.0.bucket", "aws_s3_access_point.destination", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.storage_class", string(types.ReplicationStorageClassOutposts)),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.prefix", "test1/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "test"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.status", string(types.ReplicationRuleStatusEnabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_prefix(rName, "test2/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.prefix", "test2/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketReplicationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_bucket_replication_configuration" {
+				continue
+			}
+
+			parsedArn, err := arn.Parse(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3control.FindBucketReplicationConfigurationByTwoPartKey(ctx, conn, parsedArn.AccountID, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Control Bucket Replication Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		parsedArn, err := arn.Parse(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3control.FindBucketReplicationConfigurationByTwoPartKey(ctx, conn, parsedArn.AccountID, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// testAccCheckBucketEnableVersioning enables versioning on an S3 on Outposts
+// bucket outside of Terraform.
+func testAccCheckBucketEnableVersioning(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		parsedArn, err := arn.Parse(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.PutBucketVersioning(ctx, &s3control.PutBucketVersioningInput{
+			AccountId: aws.String(parsedArn.AccountID),
+			Bucket:    aws.String(rs.Primary.ID),
+			VersioningConfiguration: &types.VersioningConfiguration{
+				Status: types.BucketVersioningStatusEnabled,
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccBucketReplicationConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "source" {
+  bucket     = "%[1]s-src"
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket" "destination" {
+  bucket     = "%[1]s-dst"
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_access_point" "source" {
+  bucket = aws_s3control_bucket.source.arn
+  name   = "%[1]s-src"
+
+  vpc_configuration {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_s3_access_point" "destination" {
+  bucket = aws_s3control_bucket.destination.arn
+  name   = "%[1]s-dst"
+
+  vpc_configuration {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "s3-outposts.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBucketReplicationConfigurationConfig_prefix(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_bucket_replication_configuration" "test" {
+  bucket = aws_s3control_bucket.source.arn
+  role   = aws_iam_role.test.arn
+
+  rule {
+    bucket   = aws_s3_access_point.source.arn
+    id       = "test"
+    priority = 1
+    status   = "Enabled"
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket = aws_s3_access_point.destination.arn
+    }
+
+    filter {
+      prefix = %[1]q
+    }
+  }
+}
+`, prefix))
+}
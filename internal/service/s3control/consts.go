@@ -10,3 +10,9 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// accessGrantsDefaultLocationScope is the location scope of the default
+	// S3 Access Grants location, covering all buckets in the Region.
+	accessGrantsDefaultLocationScope = "s3://"
+)
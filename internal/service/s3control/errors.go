@@ -3,9 +3,18 @@
 
 package s3control
 
+import (
+	"errors"
+	"fmt"
+
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
+	errCodeAccessDenied                         = "AccessDenied"
 	errCodeAccessGrantsLocationNotEmptyError    = "AccessGrantsLocationNotEmptyError"
 	errCodeInvalidBucketState                   = "InvalidBucketState"
 	errCodeInvalidIAMRole                       = "InvalidIamRole"
@@ -20,4 +29,42 @@ const (
 	errCodeNoSuchOutpost                        = "NoSuchOutpost"
 	errCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
 	errCodeNoSuchTagSet                         = "NoSuchTagSet"
+	errCodeNoSuchUpload                         = "NoSuchUpload"
+	errCodeReplicationConfigurationNotFound     = "ReplicationConfigurationNotFoundError"
+	errCodeSlowDown                             = "SlowDown"
+	errCodeThrottling                           = "Throttling"
+	errCodeThrottlingException                  = "ThrottlingException"
+	errCodeTooManyRequests                      = "TooManyRequests"
+	errCodeTooManyRequestsException             = "TooManyRequestsException"
 )
+
+// httpStatusCode returns the HTTP status code of the response that caused err,
+// or 0 if err doesn't wrap an HTTP response error.
+func httpStatusCode(err error) int {
+	var re *smithyhttp.ResponseError
+
+	if errors.As(err, &re) {
+		return re.HTTPStatusCode()
+	}
+
+	return 0
+}
+
+// withHTTPStatusCode prefixes err with the HTTP status code of the response
+// that caused it, if any. The SDK includes the status code deep in the error
+// message; surfacing it first makes it quicker to tell e.g. a 403 from a 409.
+func withHTTPStatusCode(err error) error {
+	if code := httpStatusCode(err); code != 0 {
+		return fmt.Errorf("HTTP %d: %w", code, err)
+	}
+
+	return err
+}
+
+// isListTagsUnsupportedError returns whether err, returned by
+// ListTagsForResource, means the resource's tags can't be listed at all: either
+// the API doesn't support the resource type or the caller isn't allowed to call
+// it.
+func isListTagsUnsupportedError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeInvalidRequest)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestWithHTTPStatusCode(t *testing.T) {
+	t.Parallel()
+
+	responseError := func(statusCode int) error {
+		return &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{
+					Response: &http.Response{StatusCode: statusCode},
+				},
+				Err: errors.New("api error"),
+			},
+			RequestID: "EXAMPLE",
+		}
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected int
+	}{
+		"forbidden": {
+			err:      responseError(http.StatusForbidden),
+			expected: http.StatusForbidden,
+		},
+		"conflict wrapped": {
+			err:      fmt.Errorf("operation error: %w", responseError(http.StatusConflict)),
+			expected: http.StatusConflict,
+		},
+		"not an HTTP error": {
+			err:      errors.New("validation error"),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := httpStatusCode(testCase.err), testCase.expected; got != want {
+				t.Errorf("httpStatusCode = %d, expected %d", got, want)
+			}
+
+			err := withHTTPStatusCode(testCase.err)
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("withHTTPStatusCode(%q) doesn't wrap the original error", testCase.err)
+			}
+
+			if testCase.expected == 0 {
+				if err.Error() != testCase.err.Error() {
+					t.Errorf("got %q, expected %q", err, testCase.err)
+				}
+			} else if want := fmt.Sprintf("HTTP %d: ", testCase.expected); err.Error()[:len(want)] != want {
+				t.Errorf("got %q, expected prefix %q", err, want)
+			}
+		})
+	}
+}
+
+func TestIsListTagsUnsupportedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"access denied": {
+			err:      &smithy.GenericAPIError{Code: errCodeAccessDenied},
+			expected: true,
+		},
+		"invalid request": {
+			err:      fmt.Errorf("listing tags: %w", &smithy.GenericAPIError{Code: errCodeInvalidRequest}),
+			expected: true,
+		},
+		"throttling": {
+			err:      &smithy.GenericAPIError{Code: errCodeThrottling},
+			expected: false,
+		},
+		"no error": {
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isListTagsUnsupportedError(testCase.err), testCase.expected; got != want {
+				t.Errorf("isListTagsUnsupportedError = %t, expected %t", got, want)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ var (
 	ResourceBucket                             = resourceBucket
 	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
 	ResourceBucketPolicy                       = resourceBucketPolicy
+	ResourceBucketReplicationConfiguration     = resourceBucketReplicationConfiguration
 	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
 	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
@@ -30,6 +31,7 @@ var (
 	FindBucketByTwoPartKey                                 = findBucketByTwoPartKey
 	FindBucketLifecycleConfigurationByTwoPartKey           = findBucketLifecycleConfigurationByTwoPartKey
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
+	FindBucketReplicationConfigurationByTwoPartKey         = findBucketReplicationConfigurationByTwoPartKey
 	FindMultiRegionAccessPointByTwoPartKey                 = findMultiRegionAccessPointByTwoPartKey
 	FindMultiRegionAccessPointPolicyDocumentByTwoPartKey   = findMultiRegionAccessPointPolicyDocumentByTwoPartKey
 	FindObjectLambdaAccessPointAliasByTwoPartKey           = findObjectLambdaAccessPointAliasByTwoPartKey
@@ -37,4 +39,21 @@ var (
 	FindObjectLambdaAccessPointPolicyAndStatusByTwoPartKey = findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey
 	FindPublicAccessBlockByAccountID                       = findPublicAccessBlockByAccountID
 	FindStorageLensConfigurationByAccountIDAndConfigID     = findStorageLensConfigurationByAccountIDAndConfigID
+
+	AssociateAccessGrantsInstanceIdentityCenterInstance    = associateAccessGrantsInstanceIdentityCenterInstance
+	CheckAccessPointPolicyNotExists                        = checkAccessPointPolicyNotExists
+	DisassociateAccessGrantsInstanceIdentityCenterInstance = disassociateAccessGrantsInstanceIdentityCenterInstance
+	ExpandLifecycleRuleFilter                              = expandLifecycleRuleFilter
+	MultiRegionAccessPointPolicyTargetsAccessPoint         = multiRegionAccessPointPolicyTargetsAccessPoint
+	ValidateMultiRegionAccessPointPolicyResources          = validateMultiRegionAccessPointPolicyResources
+	StatusAccessPointAlias                                 = statusAccessPointAlias
+	StatusMultiRegionAccessPointRequest                    = statusMultiRegionAccessPointRequest
+	ValidateAccessPointVPCConfigurationChange              = validateAccessPointVPCConfigurationChange
+	ValidateStorageLensS3BucketDestination                 = validateStorageLensS3BucketDestination
+	WaitPublicAccessBlockEqual                             = waitPublicAccessBlockEqual
+)
+
+const (
+	AccessPointAliasStatusAvailable = accessPointAliasStatusAvailable
+	AccessPointAliasStatusPending   = accessPointAliasStatusPending
 )
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// fakeResponse is an HTTP response returned by a client from newFakeClient.
+type fakeResponse struct {
+	statusCode int
+	body       string
+}
+
+// newFakeClient returns an S3 Control client that doesn't call AWS. Its
+// requests are answered by responses in order, the last response being
+// repeated once the others are used up. The returned counter holds the number
+// of requests made.
+func newFakeClient(responses ...fakeResponse) (*s3control.Client, *atomic.Int32) {
+	var requests atomic.Int32
+
+	conn := s3control.New(s3control.Options{
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			response := responses[min(int(requests.Add(1)), len(responses))-1]
+
+			return &http.Response{
+				StatusCode: response.statusCode,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(response.body)),
+			}, nil
+		}),
+		Region:  names.USWest2RegionID,
+		Retryer: aws.NopRetryer{},
+	})
+
+	return conn, &requests
+}
@@ -20,16 +20,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_s3control_multi_region_access_point")
+// @SDKResource("aws_s3control_multi_region_access_point", name="Multi-Region Access Point")
+// @Tags
 func resourceMultiRegionAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMultiRegionAccessPointCreate,
 		ReadWithoutTimeout:   resourceMultiRegionAccessPointRead,
+		UpdateWithoutTimeout: resourceMultiRegionAccessPointUpdate,
 		DeleteWithoutTimeout: resourceMultiRegionAccessPointDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -49,6 +53,30 @@ func resourceMultiRegionAccessPoint() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
 			},
+			"account_public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"alias": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -146,7 +174,11 @@ func resourceMultiRegionAccessPoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -167,9 +199,11 @@ func resourceMultiRegionAccessPointCreate(ctx context.Context, d *schema.Resourc
 
 	id := MultiRegionAccessPointCreateResourceID(accountID, aws.ToString(input.Details.Name))
 
-	output, err := conn.CreateMultiRegionAccessPoint(ctx, input, func(o *s3control.Options) {
-		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
-		o.Region = names.USWest2RegionID
+	output, err := retryWhenThrottled(ctx, d.Timeout(schema.TimeoutCreate), func() (*s3control.CreateMultiRegionAccessPointOutput, error) {
+		return conn.CreateMultiRegionAccessPoint(ctx, input, func(o *s3control.Options) {
+			// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+			o.Region = names.USWest2RegionID
+		})
 	})
 
 	if err != nil {
@@ -182,10 +216,25 @@ func resourceMultiRegionAccessPointCreate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("waiting for S3 Multi-Region Access Point (%s) create: %s", d.Id(), err)
 	}
 
+	// CreateMultiRegionAccessPoint doesn't accept tags, so they're applied once
+	// the access point exists.
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
+		accessPoint, err := findMultiRegionAccessPointByTwoPartKey(ctx, conn, accountID, aws.ToString(input.Details.Name))
+
+		if err != nil {
+			return diag.Errorf("creating S3 Multi-Region Access Point (%s): finding alias to tag: %s", d.Id(), err)
+		}
+
+		if err := updateTags(ctx, conn, multiRegionAccessPointARN(meta.(*conns.AWSClient).Partition, accountID, aws.ToString(accessPoint.Alias)), accountID, nil, tags, withMultiRegionAccessPointRegion); err != nil {
+			return diag.Errorf("setting S3 Multi-Region Access Point (%s) tags: %s", d.Id(), err)
+		}
+	}
+
 	return resourceMultiRegionAccessPointRead(ctx, d, meta)
 }
 
 func resourceMultiRegionAccessPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
 
 	accountID, name, err := MultiRegionAccessPointParseResourceID(d.Id())
@@ -206,12 +255,7 @@ func resourceMultiRegionAccessPointRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	alias := aws.ToString(accessPoint.Alias)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "s3",
-		AccountID: accountID,
-		Resource:  fmt.Sprintf("accesspoint/%s", alias),
-	}.String()
+	arn := multiRegionAccessPointARN(meta.(*conns.AWSClient).Partition, accountID, alias)
 	d.Set("account_id", accountID)
 	d.Set("alias", alias)
 	d.Set("arn", arn)
@@ -222,7 +266,61 @@ func resourceMultiRegionAccessPointRead(ctx context.Context, d *schema.ResourceD
 	d.Set("domain_name", meta.(*conns.AWSClient).PartitionHostname(ctx, alias+".accesspoint.s3-global"))
 	d.Set("status", accessPoint.Status)
 
-	return nil
+	// The account-wide block is managed by aws_s3_account_public_access_block;
+	// it's exposed here so it can be inspected alongside the per-MRAP block.
+	// Callers allowed to manage the access point may still lack
+	// s3:GetAccountPublicAccessBlock, which mustn't stop them reading it.
+	accountPublicAccessBlock, err := findPublicAccessBlockByAccountID(ctx, conn, accountID)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("account_public_access_block", nil)
+	case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+		d.Set("account_public_access_block", nil)
+		diags = sdkdiag.AppendWarningf(diags, "reading S3 Account Public Access Block (%s), account_public_access_block will be empty: %s", accountID, err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Account Public Access Block (%s): %s", accountID, err)
+	default:
+		if err := d.Set("account_public_access_block", []interface{}{flattenPublicAccessBlockConfiguration(accountPublicAccessBlock)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting account_public_access_block: %s", err)
+		}
+	}
+
+	tags, err := listTags(ctx, conn, arn, accountID, withMultiRegionAccessPointRegion)
+
+	switch {
+	// Tags can't be listed for Multi-Region Access Points in every partition,
+	// nor by every caller allowed to read them. That only matters if tags are
+	// configured.
+	case isListTagsUnsupportedError(err) && len(d.Get(names.AttrTagsAll).(map[string]interface{})) == 0:
+		log.Printf("[DEBUG] Skipping tags for S3 Multi-Region Access Point (%s): %s", d.Id(), err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Multi-Region Access Point (%s): %s", d.Id(), err)
+	default:
+		setTagsOut(ctx, Tags(tags))
+	}
+
+	return diags
+}
+
+func resourceMultiRegionAccessPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	// Tags are the only attribute that can be updated in place.
+	if d.HasChange("tags_all") {
+		accountID, _, err := MultiRegionAccessPointParseResourceID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, n := d.GetChange("tags_all")
+
+		if err := updateTags(ctx, conn, d.Get("arn").(string), accountID, o, n, withMultiRegionAccessPointRegion); err != nil {
+			return diag.Errorf("updating S3 Multi-Region Access Point (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMultiRegionAccessPointRead(ctx, d, meta)
 }
 
 func resourceMultiRegionAccessPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -241,9 +339,11 @@ func resourceMultiRegionAccessPointDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Deleting S3 Multi-Region Access Point: %s", d.Id())
-	output, err := conn.DeleteMultiRegionAccessPoint(ctx, input, func(o *s3control.Options) {
-		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
-		o.Region = names.USWest2RegionID
+	output, err := retryWhenThrottled(ctx, d.Timeout(schema.TimeoutDelete), func() (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+		return conn.DeleteMultiRegionAccessPoint(ctx, input, func(o *s3control.Options) {
+			// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+			o.Region = names.USWest2RegionID
+		})
 	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
@@ -319,6 +419,14 @@ func findMultiRegionAccessPointOperationByTwoPartKey(ctx context.Context, conn *
 	return output.AsyncOperation, nil
 }
 
+const (
+	// AsyncOperation.RequestStatus values.
+	asyncOperationRequestStatusFailed     = "FAILED"
+	asyncOperationRequestStatusInProgress = "INPROGRESS"
+	asyncOperationRequestStatusNew        = "NEW"
+	asyncOperationRequestStatusSucceeded  = "SUCCEEDED"
+)
+
 func statusMultiRegionAccessPointRequest(ctx context.Context, conn *s3control.Client, accountID, requestTokenARN string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findMultiRegionAccessPointOperationByTwoPartKey(ctx, conn, accountID, requestTokenARN)
@@ -331,17 +439,20 @@ func statusMultiRegionAccessPointRequest(ctx context.Context, conn *s3control.Cl
 			return nil, "", err
 		}
 
-		return output, aws.ToString(output.RequestStatus), nil
+		status := aws.ToString(output.RequestStatus)
+
+		if responseDetails := output.ResponseDetails; status == asyncOperationRequestStatusFailed && responseDetails != nil && responseDetails.ErrorDetails != nil {
+			return output, status, fmt.Errorf("%s: %s", aws.ToString(responseDetails.ErrorDetails.Code), aws.ToString(responseDetails.ErrorDetails.Message))
+		}
+
+		return output, status, nil
 	}
 }
 
 func waitMultiRegionAccessPointRequestSucceeded(ctx context.Context, conn *s3control.Client, accountID, requestTokenARN string, timeout time.Duration) (*types.AsyncOperation, error) { //nolint:unparam
-	const (
-		// AsyncOperation.RequestStatus values.
-		asyncOperationRequestStatusFailed    = "FAILED"
-		asyncOperationRequestStatusSucceeded = "SUCCEEDED"
-	)
 	stateConf := &retry.StateChangeConf{
+		// FAILED is neither pending nor a target, so the wait ends as soon as it's seen.
+		Pending:    []string{asyncOperationRequestStatusNew, asyncOperationRequestStatusInProgress},
 		Target:     []string{asyncOperationRequestStatusSucceeded},
 		Timeout:    timeout,
 		Refresh:    statusMultiRegionAccessPointRequest(ctx, conn, accountID, requestTokenARN),
@@ -352,16 +463,27 @@ func waitMultiRegionAccessPointRequestSucceeded(ctx context.Context, conn *s3con
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.AsyncOperation); ok {
-		if status, responseDetails := aws.ToString(output.RequestStatus), output.ResponseDetails; status == asyncOperationRequestStatusFailed && responseDetails != nil && responseDetails.ErrorDetails != nil {
-			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(responseDetails.ErrorDetails.Code), aws.ToString(responseDetails.ErrorDetails.Message)))
-		}
-
 		return output, err
 	}
 
 	return nil, err
 }
 
+// withMultiRegionAccessPointRegion routes a request to the US West (Oregon)
+// Region, which serves all Multi-Region Access Point actions.
+func withMultiRegionAccessPointRegion(o *s3control.Options) {
+	o.Region = names.USWest2RegionID
+}
+
+func multiRegionAccessPointARN(partition, accountID, alias string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "s3",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", alias),
+	}.String()
+}
+
 const multiRegionAccessPointResourceIDSeparator = ":"
 
 func MultiRegionAccessPointCreateResourceID(accountID, accessPointName string) string {
@@ -55,6 +55,36 @@ func TestAccS3ControlMultiRegionAccessPointDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlMultiRegionAccessPointDataSource_accountID(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	dataSourceName := "data.aws_s3control_multi_region_access_point.test"
+	bucket1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointDataSourceConfig_accountID(bucket1Name, bucket2Name, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "details.0.name", dataSourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccMultiRegionAccessPointDataSource_base(bucket1Name string, bucket2Name string, rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
@@ -109,3 +139,20 @@ data "aws_s3control_multi_region_access_point" "test" {
 }
 `, rName))
 }
+
+func testAccMultiRegionAccessPointDataSourceConfig_accountID(bucket1Name string, bucket2Name string, rName string) string {
+	return acctest.ConfigCompose(testAccMultiRegionAccessPointDataSource_base(bucket1Name, bucket2Name, rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {
+  provider = aws
+}
+
+data "aws_s3control_multi_region_access_point" "test" {
+  provider = aws
+
+  account_id = data.aws_caller_identity.current.account_id
+  name       = %[1]q
+
+  depends_on = [aws_s3control_multi_region_access_point.test]
+}
+`, rName))
+}
@@ -5,13 +5,16 @@ package s3control
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -64,7 +67,7 @@ func resourceMultiRegionAccessPointPolicy() *schema.Resource {
 						"policy": {
 							Type:                  schema.TypeString,
 							Required:              true,
-							ValidateFunc:          validation.StringIsJSON,
+							ValidateDiagFunc:      validation.AllDiag(validation.ToDiagFunc(validation.StringIsJSON), validateMultiRegionAccessPointPolicyResources),
 							DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 							DiffSuppressOnRefresh: true,
 							StateFunc: func(v interface{}) string {
@@ -87,6 +90,75 @@ func resourceMultiRegionAccessPointPolicy() *schema.Resource {
 	}
 }
 
+// validateMultiRegionAccessPointPolicyResources warns when no statement of the policy targets
+// a Multi-Region Access Point. A common mistake is to copy a bucket policy, whose statements
+// target bucket ARNs. The API accepts such a policy, but it then grants nothing on the
+// Multi-Region Access Point.
+func validateMultiRegionAccessPointPolicyResources(v interface{}, path cty.Path) diag.Diagnostics {
+	policy, ok := v.(string)
+	if !ok || policy == "" {
+		return nil
+	}
+
+	if ok, err := multiRegionAccessPointPolicyTargetsAccessPoint(policy); err != nil || ok {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Policy doesn't target a Multi-Region Access Point",
+			Detail:        "No statement of the policy has a Resource that is a Multi-Region Access Point ARN (arn:<partition>:s3::<account-id>:accesspoint/<alias>) or *, so the policy grants nothing on the Multi-Region Access Point.",
+			AttributePath: path,
+		},
+	}
+}
+
+var multiRegionAccessPointARNRegexp = regexache.MustCompile(`^arn:[^:]+:s3::[^:]*:accesspoint/`)
+
+// multiRegionAccessPointPolicyTargetsAccessPoint returns whether any statement in the
+// specified policy document has a Resource that is a Multi-Region Access Point ARN or wildcard.
+func multiRegionAccessPointPolicyTargetsAccessPoint(policy string) (bool, error) {
+	var document struct {
+		Statement interface{} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+
+	var statements []interface{}
+	switch v := document.Statement.(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	for _, v := range statements {
+		statement, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var resources []interface{}
+		switch v := statement["Resource"].(type) {
+		case []interface{}:
+			resources = v
+		case string:
+			resources = []interface{}{v}
+		}
+
+		for _, v := range resources {
+			if resource, ok := v.(string); ok && (resource == "*" || multiRegionAccessPointARNRegexp.MatchString(resource)) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 func resourceMultiRegionAccessPointPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
 
@@ -10,6 +10,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -19,6 +21,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestMultiRegionAccessPointPolicyTargetsAccessPoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected bool
+		err      bool
+	}{
+		"multi-region access point ARN": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"}]}`, // lintignore:AWSAT005
+			expected: true,
+		},
+		"resource list": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::example","arn:aws-cn:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"]}}`, // lintignore:AWSAT005
+			expected: true,
+		},
+		"wildcard": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: true,
+		},
+		"bucket ARN": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`, // lintignore:AWSAT005
+			expected: false,
+		},
+		"access point ARN": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/*"}]}`, // lintignore:AWSAT003,AWSAT005
+			expected: false,
+		},
+		"no statements": {
+			policy:   `{"Version":"2012-10-17"}`,
+			expected: false,
+		},
+		"invalid JSON": {
+			policy: `{`,
+			err:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3control.MultiRegionAccessPointPolicyTargetsAccessPoint(testCase.policy)
+
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidateMultiRegionAccessPointPolicyResources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		warnings int
+	}{
+		"multi-region access point ARN": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"}]}`, // lintignore:AWSAT005
+		},
+		"bucket ARN": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`, // lintignore:AWSAT005
+			warnings: 1,
+		},
+		"invalid JSON": {
+			policy: `{`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := cty.GetAttrPath("details").IndexInt(0).GetAttr("policy")
+			diags := tfs3control.ValidateMultiRegionAccessPointPolicyResources(testCase.policy, path)
+
+			if got, want := len(diags), testCase.warnings; got != want {
+				t.Fatalf("got %d diagnostics, expected %d", got, want)
+			}
+
+			for _, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("got severity %v, expected warning", d.Severity)
+				}
+
+				if !d.AttributePath.Equals(path) {
+					t.Errorf("got attribute path %#v, expected %#v", d.AttributePath, path)
+				}
+			}
+		})
+	}
+}
+
 func TestAccS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.MultiRegionAccessPointPolicyDocument
@@ -6,6 +6,8 @@ package s3control_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -21,6 +23,72 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestStatusMultiRegionAccessPointRequest(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID       = "123456789012"
+		requestTokenARN = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/create/EXAMPLE" //lintignore:AWSAT003,AWSAT005
+	)
+
+	operation := func(status, errorDetails string) fakeResponse {
+		return fakeResponse{
+			statusCode: http.StatusOK,
+			body:       fmt.Sprintf(`<DescribeMultiRegionAccessPointOperationResult><AsyncOperation><RequestStatus>%s</RequestStatus>%s</AsyncOperation></DescribeMultiRegionAccessPointOperationResult>`, status, errorDetails),
+		}
+	}
+
+	testCases := map[string]struct {
+		responses      []fakeResponse
+		expectedStates []string
+		expectedError  *regexp.Regexp
+	}{
+		"succeeds after progressing": {
+			responses: []fakeResponse{
+				operation("INPROGRESS", ""),
+				operation("SUCCEEDED", ""),
+			},
+			expectedStates: []string{"INPROGRESS", "SUCCEEDED"},
+		},
+		"fails with reason": {
+			responses: []fakeResponse{
+				operation("INPROGRESS", ""),
+				operation("FAILED", "<ResponseDetails><ErrorDetails><Code>BucketNotFound</Code><Message>bucket does not exist</Message></ErrorDetails></ResponseDetails>"),
+			},
+			expectedStates: []string{"INPROGRESS", "FAILED"},
+			expectedError:  regexache.MustCompile(`BucketNotFound: bucket does not exist`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newFakeClient(testCase.responses...)
+			refresh := tfs3control.StatusMultiRegionAccessPointRequest(context.Background(), conn, accountID, requestTokenARN)
+
+			var err error
+			for i, want := range testCase.expectedStates {
+				var got string
+				_, got, err = refresh()
+
+				if got != want {
+					t.Fatalf("refresh %d: got state %q, want %q", i, got, want)
+				}
+			}
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
 func TestAccS3ControlMultiRegionAccessPoint_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.MultiRegionAccessPointReport
@@ -56,6 +124,7 @@ func TestAccS3ControlMultiRegionAccessPoint_basic(t *testing.T) {
 						"region":            acctest.Region(),
 					}),
 					resource.TestCheckResourceAttr(resourceName, "status", string(types.MultiRegionAccessPointStatusReady)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
 			{
@@ -67,6 +136,40 @@ func TestAccS3ControlMultiRegionAccessPoint_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlMultiRegionAccessPoint_aliasStable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointConfig_basic(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v1),
+					resource.TestMatchResourceAttr(resourceName, "alias", regexache.MustCompile(`^[a-z][0-9a-z]*[.]mrap$`)),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v2),
+					testAccCheckMultiRegionAccessPointNotRecreated(&v1, &v2),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr(resourceName, "alias", aws.ToString(v1.Alias))(s)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ControlMultiRegionAccessPoint_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.MultiRegionAccessPointReport
@@ -92,6 +195,55 @@ func TestAccS3ControlMultiRegionAccessPoint_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlMultiRegionAccessPoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 types.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointConfig_tags1(bucketName, rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMultiRegionAccessPointConfig_tags2(bucketName, rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v2),
+					testAccCheckMultiRegionAccessPointNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMultiRegionAccessPointConfig_tags1(bucketName, rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v3),
+					testAccCheckMultiRegionAccessPointNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ControlMultiRegionAccessPoint_PublicAccessBlock(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.MultiRegionAccessPointReport
@@ -125,6 +277,60 @@ func TestAccS3ControlMultiRegionAccessPoint_PublicAccessBlock(t *testing.T) {
 	})
 }
 
+// testAccMultiRegionAccessPoint_accountPublicAccessBlock modifies the account-level
+// public access block, so it runs in TestAccS3ControlAccountPublicAccessBlock_serial.
+func testAccMultiRegionAccessPoint_accountPublicAccessBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointConfig_accountPublicAccessBlock(bucketName, rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "account_public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_acls", "false"),
+				),
+			},
+			{
+				// Modify the account-wide block only; the MRAP must not be replaced.
+				Config: testAccMultiRegionAccessPointConfig_accountPublicAccessBlock(bucketName, rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v2),
+					testAccCheckMultiRegionAccessPointNotRecreated(&v1, &v2),
+				),
+			},
+			{
+				// Refresh to pick up the account-wide change made in the previous step.
+				Config: testAccMultiRegionAccessPointConfig_accountPublicAccessBlock(bucketName, rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_public_access_block.0.block_public_acls", "false"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_acls", "false"),
+				),
+			},
+			{
+				// Modify the per-MRAP block only; the account-wide block is unchanged.
+				Config: testAccMultiRegionAccessPointConfig_accountPublicAccessBlock(bucketName, rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v2),
+					testAccCheckMultiRegionAccessPointRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "account_public_access_block.0.block_public_acls", "false"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.public_access_block.0.block_public_acls", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ControlMultiRegionAccessPoint_name(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 types.MultiRegionAccessPointReport
@@ -208,6 +414,46 @@ func TestAccS3ControlMultiRegionAccessPoint_threeRegions(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlMultiRegionAccessPoint_crossAccountBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.MultiRegionAccessPointReport
+	resourceName := "aws_s3control_multi_region_access_point.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointConfig_crossAccountBucket(bucketName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.region.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "details.0.region.*", map[string]string{
+						"bucket": bucketName,
+						"region": acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "details.0.region.*.bucket_account_id", "data.aws_caller_identity.bucket_owner", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.MultiRegionAccessPointStatusReady)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3ControlMultiRegionAccessPoint_putAndGetObject(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.MultiRegionAccessPointReport
@@ -301,6 +547,16 @@ func testAccCheckMultiRegionAccessPointRecreated(before, after *types.MultiRegio
 	}
 }
 
+func testAccCheckMultiRegionAccessPointNotRecreated(before, after *types.MultiRegionAccessPointReport) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Alias), aws.ToString(after.Alias); before != after {
+			return fmt.Errorf("S3 Multi-Region Access Point (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccMultiRegionAccessPointConfig_basic(bucketName, multiRegionAccessPointName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -320,6 +576,53 @@ resource "aws_s3control_multi_region_access_point" "test" {
 `, bucketName, multiRegionAccessPointName)
 }
 
+func testAccMultiRegionAccessPointConfig_tags1(bucketName, multiRegionAccessPointName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, bucketName, multiRegionAccessPointName, tagKey1, tagValue1)
+}
+
+func testAccMultiRegionAccessPointConfig_tags2(bucketName, multiRegionAccessPointName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, bucketName, multiRegionAccessPointName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
 func testAccMultiRegionAccessPointConfig_publicBlock(bucketName, multiRegionAccessPointName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -346,6 +649,38 @@ resource "aws_s3control_multi_region_access_point" "test" {
 `, bucketName, multiRegionAccessPointName)
 }
 
+func testAccMultiRegionAccessPointConfig_accountPublicAccessBlock(bucketName, multiRegionAccessPointName string, accountBlockPublicACLs, blockPublicACLs bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_account_public_access_block" "test" {
+  block_public_acls = %[3]t
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    public_access_block {
+      block_public_acls       = %[4]t
+      block_public_policy     = false
+      ignore_public_acls      = false
+      restrict_public_buckets = false
+    }
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+
+  depends_on = [aws_s3_account_public_access_block.test]
+}
+`, bucketName, multiRegionAccessPointName, accountBlockPublicACLs, blockPublicACLs)
+}
+
 func testAccMultiRegionAccessPointConfig_three(bucketName1, bucketName2, bucketName3, multiRegionAccessPointName string) string {
 	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(3), fmt.Sprintf(`
 resource "aws_s3_bucket" "test1" {
@@ -391,6 +726,32 @@ resource "aws_s3control_multi_region_access_point" "test" {
 `, bucketName1, bucketName2, bucketName3, multiRegionAccessPointName))
 }
 
+func testAccMultiRegionAccessPointConfig_crossAccountBucket(bucketName, multiRegionAccessPointName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "bucket_owner" {
+  provider = "awsalternate"
+}
+
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket            = aws_s3_bucket.test.id
+      bucket_account_id = data.aws_caller_identity.bucket_owner.account_id
+    }
+  }
+}
+`, bucketName, multiRegionAccessPointName))
+}
+
 func testAccMultiRegionAccessPointConfig_putAndGetObject(bucketName, multiRegionAccessPointName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
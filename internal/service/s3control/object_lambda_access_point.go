@@ -74,7 +74,7 @@ func resourceObjectLambdaAccessPoint() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: validAccessPointARN,
 						},
 						"transformation_configuration": {
 							Type:     schema.TypeSet,
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlObjectLambdaAccessPointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+	dataSourceName := "data.aws_s3control_object_lambda_access_point.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLambdaAccessPointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.supporting_access_point", resourceName, "configuration.0.supporting_access_point"),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.0.transformation_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "configuration.0.transformation_configuration.*.actions.*", "GetObject"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "configuration.0.transformation_configuration.*.content_transformation.0.aws_lambda.0.function_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectLambdaAccessPointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointConfig_basic(rName), `
+data "aws_s3control_object_lambda_access_point" "test" {
+  name = aws_s3control_object_lambda_access_point.test.name
+}
+`)
+}
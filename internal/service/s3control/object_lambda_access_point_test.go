@@ -156,6 +156,41 @@ func TestAccS3ControlObjectLambdaAccessPoint_update(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlObjectLambdaAccessPoint_multipleTransformations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ObjectLambdaConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_object_lambda_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectLambdaAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLambdaAccessPointConfig_multipleTransformations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectLambdaAccessPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.transformation_configuration.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "GetObject"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "HeadObject"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "ListObjects"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.transformation_configuration.*.actions.*", "ListObjectsV2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "configuration.0.transformation_configuration.*.content_transformation.0.aws_lambda.0.function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "configuration.0.transformation_configuration.*.content_transformation.0.aws_lambda.0.function_arn", "aws_lambda_function.list", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckObjectLambdaAccessPointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -289,3 +324,52 @@ resource "aws_s3control_object_lambda_access_point" "test" {
 }
 `, rName))
 }
+
+func testAccObjectLambdaAccessPointConfig_multipleTransformations(rName string) string {
+	return acctest.ConfigCompose(testAccObjectLambdaAccessPointBaseConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "list" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-list"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+}
+
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject", "HeadObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+
+    transformation_configuration {
+      actions = ["ListObjects", "ListObjectsV2"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.list.arn
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3control_resource_tags", name="Resource Tags")
+func dataSourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourceTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validTaggableResourceARN,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resourceARN := d.Get(names.AttrARN).(string)
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing S3 Control resource ARN (%s): %s", resourceARN, err)
+	}
+
+	var optFns []func(*s3control.Options)
+
+	// Multi-Region Access Point ARNs have no Region; their tags are managed in us-west-2.
+	if parsedARN.Region == "" {
+		optFns = append(optFns, withMultiRegionAccessPointRegion)
+	}
+
+	tags, err := listTags(ctx, conn, resourceARN, parsedARN.AccountID, optFns...)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Control resource (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+	d.Set("account_id", parsedARN.AccountID)
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// throttledRetryTimeout bounds how long retryWhenThrottled keeps retrying. Each
+// call already makes up to max_retries attempts in the SDK's retryer, so this
+// only rides out throttling that outlasts those, e.g. during bulk applies.
+const throttledRetryTimeout = 2 * time.Minute
+
+// retryWhenThrottled calls f, retrying while S3 Control throttles the request
+// once the SDK's retryer has given up. Retries stop after throttledRetryTimeout,
+// or sooner when timeout expires or ctx's deadline is reached, so that retrying
+// never outlives the operation's own timeout.
+func retryWhenThrottled[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return retryWhenThrottledOrAWSErrCodeEquals(ctx, min(timeout, throttledRetryTimeout), f)
+}
+
+// retryWhenThrottledOrAWSErrCodeEquals is retryWhenThrottled that also retries
+// while f returns an error with one of the specified codes. A single retry
+// loop covers both, so that one kind of retry can't multiply the other.
+func retryWhenThrottledOrAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(timeout, max(time.Until(deadline), 0))
+	}
+
+	return tfresource.RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if len(codes) > 0 && tfawserr.ErrCodeEquals(err, codes...) {
+			return true, err
+		}
+
+		return throttlingRetryable(err)
+	})
+}
+
+// throttlingRetryable classifies the errors S3 Control returns when requests
+// are being throttled.
+func throttlingRetryable(err error) (bool, error) {
+	if tfawserr.ErrCodeEquals(err, errCodeSlowDown, errCodeThrottling, errCodeThrottlingException, errCodeTooManyRequests, errCodeTooManyRequestsException) {
+		return true, err
+	}
+
+	if httpStatusCode(err) == http.StatusTooManyRequests {
+		return true, err
+	}
+
+	return false, err
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestThrottlingRetryable(t *testing.T) {
+	t.Parallel()
+
+	apiError := func(code string) error {
+		return &smithy.GenericAPIError{Code: code, Message: "test"}
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"SlowDown": {
+			err:      apiError(errCodeSlowDown),
+			expected: true,
+		},
+		"Throttling": {
+			err:      apiError(errCodeThrottling),
+			expected: true,
+		},
+		"ThrottlingException": {
+			err:      apiError(errCodeThrottlingException),
+			expected: true,
+		},
+		"TooManyRequests": {
+			err:      apiError(errCodeTooManyRequests),
+			expected: true,
+		},
+		"TooManyRequestsException wrapped": {
+			err:      fmt.Errorf("operation error: %w", apiError(errCodeTooManyRequestsException)),
+			expected: true,
+		},
+		"HTTP 429": {
+			err: &awshttp.ResponseError{
+				ResponseError: &smithyhttp.ResponseError{
+					Response: &smithyhttp.Response{
+						Response: &http.Response{StatusCode: http.StatusTooManyRequests},
+					},
+					Err: errors.New("api error"),
+				},
+			},
+			expected: true,
+		},
+		"NoSuchBucket": {
+			err: apiError(errCodeNoSuchBucket),
+		},
+		"not an API error": {
+			err: errors.New("validation error"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := throttlingRetryable(testCase.err)
+
+			if got != testCase.expected {
+				t.Errorf("throttlingRetryable = %t, expected %t", got, testCase.expected)
+			}
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("expected error %v, got %v", testCase.err, err)
+			}
+		})
+	}
+}
+
+func TestRetryWhenThrottled(t *testing.T) {
+	t.Parallel()
+
+	throttled := &smithy.GenericAPIError{Code: errCodeSlowDown, Message: "test"}
+
+	t.Run("retries until success", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		got, err := retryWhenThrottled(context.Background(), time.Minute, func() (string, error) {
+			if calls++; calls < 3 {
+				return "", throttled
+			}
+
+			return "ok", nil
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != "ok" || calls != 3 {
+			t.Errorf("got %q after %d calls, expected %q after 3 calls", got, calls, "ok")
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		_, err := retryWhenThrottled(context.Background(), time.Minute, func() (string, error) {
+			calls++
+			return "", errors.New("validation error")
+		})
+
+		if err == nil || calls != 1 {
+			t.Errorf("got error %v after %d calls, expected an error after 1 call", err, calls)
+		}
+	})
+
+	t.Run("retries specified codes", func(t *testing.T) {
+		t.Parallel()
+
+		invalidState := &smithy.GenericAPIError{Code: errCodeInvalidBucketState, Message: "test"}
+
+		var calls int
+		got, err := retryWhenThrottledOrAWSErrCodeEquals(context.Background(), time.Minute, func() (string, error) {
+			switch calls++; calls {
+			case 1:
+				return "", invalidState
+			case 2:
+				return "", throttled
+			default:
+				return "ok", nil
+			}
+		}, errCodeInvalidBucketState)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != "ok" || calls != 3 {
+			t.Errorf("got %q after %d calls, expected %q after 3 calls", got, calls, "ok")
+		}
+	})
+
+	t.Run("stops at context deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := retryWhenThrottled(ctx, time.Hour, func() (string, error) {
+			return "", throttled
+		})
+
+		if !errors.Is(err, throttled) {
+			t.Errorf("expected throttling error, got %v", err)
+		}
+
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("retried for %s, expected retries to stop at the context deadline", elapsed)
+		}
+	})
+}
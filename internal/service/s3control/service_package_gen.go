@@ -44,15 +44,40 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccessPoint,
+			TypeName: "aws_s3_access_point",
+			Name:     "Access Point",
+		},
 		{
 			Factory:  dataSourceAccountPublicAccessBlock,
 			TypeName: "aws_s3_account_public_access_block",
 			Name:     "Account Public Access Block",
 		},
+		{
+			Factory:  dataSourceBucket,
+			TypeName: "aws_s3control_bucket",
+			Name:     "Bucket",
+		},
 		{
 			Factory:  dataSourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
 		},
+		{
+			Factory:  dataSourceObjectLambdaAccessPoint,
+			TypeName: "aws_s3control_object_lambda_access_point",
+			Name:     "Object Lambda Access Point",
+		},
+		{
+			Factory:  dataSourceResourceTags,
+			TypeName: "aws_s3control_resource_tags",
+			Name:     "Resource Tags",
+		},
+		{
+			Factory:  dataSourceStorageLensConfiguration,
+			TypeName: "aws_s3control_storage_lens_configuration",
+			Name:     "Storage Lens Configuration",
+		},
 	}
 }
 
@@ -61,6 +86,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  resourceAccessPoint,
 			TypeName: "aws_s3_access_point",
+			Name:     "Access Point",
+			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  resourceAccountPublicAccessBlock,
@@ -85,9 +112,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3control_bucket_policy",
 		},
+		{
+			Factory:  resourceBucketReplicationConfiguration,
+			TypeName: "aws_s3control_bucket_replication_configuration",
+			Name:     "Bucket Replication Configuration",
+		},
 		{
 			Factory:  resourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
+			Name:     "Multi-Region Access Point",
+			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  resourceMultiRegionAccessPointPolicy,
@@ -5,16 +5,19 @@ package s3control
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -333,9 +336,10 @@ func resourceStorageLensConfiguration() *schema.Resource {
 							Required: true,
 						},
 						"exclude": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.include"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"buckets": {
@@ -358,9 +362,10 @@ func resourceStorageLensConfiguration() *schema.Resource {
 							},
 						},
 						"include": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.exclude"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"buckets": {
@@ -389,10 +394,53 @@ func resourceStorageLensConfiguration() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStorageLensConfigurationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceStorageLensConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const k = "storage_lens_configuration.0.data_export.0.s3_bucket_destination"
+
+	v, ok := d.Get(k).([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	return validateStorageLensS3BucketDestination(v[0].(map[string]interface{}), func(attr string) bool {
+		return d.NewValueKnown(k + ".0." + attr)
+	})
+}
+
+// validateStorageLensS3BucketDestination checks the s3_bucket_destination
+// attributes that the schema can't, so that problems are reported at plan
+// time rather than by PutStorageLensConfiguration. Attributes whose values
+// aren't yet known are skipped.
+func validateStorageLensS3BucketDestination(tfMap map[string]interface{}, known func(string) bool) error {
+	const prefix = "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0"
+	var errs []error
+
+	for _, attr := range []string{"account_id", "arn", "format", "output_schema_version"} {
+		if !known(attr) {
+			continue
+		}
+
+		if v, ok := tfMap[attr].(string); !ok || v == "" {
+			errs = append(errs, fmt.Errorf("%s.%s must be set when s3_bucket_destination is configured", prefix, attr))
+		}
+	}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" && known("arn") {
+		if parsedARN, err := arn.Parse(v); err == nil && (parsedARN.Service != "s3" || parsedARN.Region != "" || parsedARN.AccountID != "" || strings.Contains(parsedARN.Resource, "/")) {
+			errs = append(errs, fmt.Errorf("%s.arn (%s) must be an S3 bucket ARN", prefix, v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceStorageLensConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
 
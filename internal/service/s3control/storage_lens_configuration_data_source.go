@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3control_storage_lens_configuration", name="Storage Lens Configuration")
+func dataSourceStorageLensConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStorageLensConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_lens_configuration": sdkv2.DataSourcePropertyFromResourceProperty(resourceStorageLensConfiguration().Schema["storage_lens_configuration"]),
+			names.AttrTags:               tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceStorageLensConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	configID := d.Get("config_id").(string)
+	id := StorageLensConfigurationCreateResourceID(accountID, configID)
+
+	output, err := findStorageLensConfigurationByAccountIDAndConfigID(ctx, conn, accountID, configID)
+
+	if err != nil {
+		return diag.Errorf("reading S3 Storage Lens Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("account_id", accountID)
+	d.Set("arn", output.StorageLensArn)
+	d.Set("config_id", configID)
+	if err := d.Set("storage_lens_configuration", []interface{}{flattenStorageLensConfiguration(output)}); err != nil {
+		return diag.Errorf("setting storage_lens_configuration: %s", err)
+	}
+
+	tags, err := storageLensConfigurationListTags(ctx, conn, accountID, configID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Storage Lens Configuration (%s): %s", id, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	return nil
+}
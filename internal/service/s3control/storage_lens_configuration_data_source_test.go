@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlStorageLensConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	dataSourceName := "data.aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "config_id", resourceName, "config_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.#", resourceName, "storage_lens_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.enabled", resourceName, "storage_lens_configuration.0.enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled", resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.0.max_depth", resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.0.max_depth"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.arn", resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.format", resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "storage_lens_configuration.0.exclude.0.buckets.#", resourceName, "storage_lens_configuration.0.exclude.0.buckets.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageLensConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStorageLensConfigurationConfig_allAttributes(rName), `
+data "aws_s3control_storage_lens_configuration" "test" {
+  config_id = aws_s3control_storage_lens_configuration.test.config_id
+}
+`)
+}
@@ -6,8 +6,10 @@ package s3control_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -18,6 +20,116 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateStorageLensS3BucketDestination(t *testing.T) {
+	t.Parallel()
+
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"account_id":            "123456789012",
+			"arn":                   "arn:aws:s3:::example", // lintignore:AWSAT005
+			"format":                "CSV",
+			"output_schema_version": "V_1",
+			"prefix":                "",
+		}
+	}
+	allKnown := func(string) bool { return true }
+
+	testCases := map[string]struct {
+		tfMap    func() map[string]interface{}
+		known    func(string) bool
+		expected []string
+	}{
+		"valid": {
+			tfMap: valid,
+			known: allKnown,
+		},
+		"missing account_id": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["account_id"] = ""
+				return m
+			},
+			known:    allKnown,
+			expected: []string{"s3_bucket_destination.0.account_id must be set"},
+		},
+		"missing arn": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["arn"] = ""
+				return m
+			},
+			known:    allKnown,
+			expected: []string{"s3_bucket_destination.0.arn must be set"},
+		},
+		"missing format and output_schema_version": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["format"] = ""
+				m["output_schema_version"] = ""
+				return m
+			},
+			known: allKnown,
+			expected: []string{
+				"s3_bucket_destination.0.format must be set",
+				"s3_bucket_destination.0.output_schema_version must be set",
+			},
+		},
+		"unknown arn": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["arn"] = ""
+				return m
+			},
+			known: func(attr string) bool { return attr != "arn" },
+		},
+		"object ARN": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["arn"] = "arn:aws:s3:::example/prefix" // lintignore:AWSAT005
+				return m
+			},
+			known:    allKnown,
+			expected: []string{"must be an S3 bucket ARN"},
+		},
+		"non-S3 ARN": {
+			tfMap: func() map[string]interface{} {
+				m := valid()
+				m["arn"] = "arn:aws:iam::123456789012:role/example" // lintignore:AWSAT005
+				return m
+			},
+			known:    allKnown,
+			expected: []string{"must be an S3 bucket ARN"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3control.ValidateStorageLensS3BucketDestination(testCase.tfMap(), testCase.known)
+
+			if len(testCase.expected) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, want := range testCase.expected {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
 func TestAccS3ControlStorageLensConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -324,6 +436,109 @@ func TestAccS3ControlStorageLensConfiguration_advancedMetrics(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlStorageLensConfiguration_kmsEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_kmsEncryption(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.advanced_cost_optimization_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.advanced_data_protection_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.advanced_cost_optimization_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.advanced_data_protection_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_kms.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_kms.0.key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_s3.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_excludeRegions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStorageLensConfigurationConfig_includeAndExclude(rName),
+				ExpectError: regexache.MustCompile(`"storage_lens_configuration.0.(include|exclude)": conflicts with storage_lens_configuration.0.(exclude|include)`),
+			},
+			{
+				Config: testAccStorageLensConfigurationConfig_excludeRegions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.0.buckets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.0.regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "storage_lens_configuration.0.exclude.0.regions.*", "ap-east-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "storage_lens_configuration.0.exclude.0.regions.*", "eu-south-1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_awsOrg(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_awsOrg(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.aws_org.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.aws_org.0.arn", "data.aws_organizations_organization.current", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckStorageLensConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -620,3 +835,150 @@ resource "aws_s3control_storage_lens_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccStorageLensConfigurationConfig_kmsEncryption(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "Enable IAM User Permissions"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+      }, {
+      Sid    = "Allow Storage Lens to use the key"
+      Effect = "Allow"
+      Principal = {
+        Service = "storage-lens.s3.amazonaws.com"
+      }
+      Action   = ["kms:GenerateDataKey"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      advanced_cost_optimization_metrics {
+        enabled = true
+      }
+
+      advanced_data_protection_metrics {
+        enabled = true
+      }
+
+      bucket_level {
+        advanced_cost_optimization_metrics {
+          enabled = true
+        }
+
+        advanced_data_protection_metrics {
+          enabled = true
+        }
+      }
+    }
+
+    data_export {
+      s3_bucket_destination {
+        account_id            = data.aws_caller_identity.current.account_id
+        arn                   = aws_s3_bucket.test.arn
+        format                = "Parquet"
+        output_schema_version = "V_1"
+
+        encryption {
+          sse_kms {
+            key_id = aws_kms_key.test.arn
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensConfigurationConfig_excludeRegions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      bucket_level {}
+    }
+
+    exclude {
+      regions = ["ap-east-1", "eu-south-1"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensConfigurationConfig_includeAndExclude(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      bucket_level {}
+    }
+
+    exclude {
+      regions = ["eu-south-1"]
+    }
+
+    include {
+      regions = ["ap-east-1"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensConfigurationConfig_awsOrg(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      bucket_level {}
+    }
+
+    aws_org {
+      arn = data.aws_organizations_organization.current.arn
+    }
+  }
+}
+`, rName)
+}
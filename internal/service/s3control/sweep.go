@@ -90,8 +90,11 @@ func sweepAccessGrants(region string) error {
 		}
 
 		for _, v := range page.AccessGrantsList {
+			grantID := aws.ToString(v.AccessGrantId)
 			sweepResources = append(sweepResources, framework.NewSweepResource(newAccessGrantResource, client,
-				framework.NewAttribute("id", fmt.Sprintf("%s%s%s", accountID, flex.ResourceIdSeparator, aws.ToString(v.AccessGrantId))),
+				framework.NewAttribute("id", fmt.Sprintf("%s%s%s", accountID, flex.ResourceIdSeparator, grantID)),
+				framework.NewAttribute("access_grant_id", grantID),
+				framework.NewAttribute("account_id", accountID),
 			))
 		}
 	}
@@ -131,9 +134,13 @@ func sweepAccessGrantsInstances(region string) error {
 			return fmt.Errorf("error listing S3 Access Grants Instances (%s): %w", region, err)
 		}
 
-		for range page.AccessGrantsInstancesList {
+		for _, v := range page.AccessGrantsInstancesList {
+			// The IAM Identity Center instance must be dissociated before the Access Grants Instance can be deleted.
+			// A nil ARN leaves identity_center_arn null, so nothing is dissociated.
 			sweepResources = append(sweepResources, framework.NewSweepResource(newAccessGrantsInstanceResource, client,
 				framework.NewAttribute("id", accountID),
+				framework.NewAttribute("account_id", accountID),
+				framework.NewAttribute("identity_center_arn", v.IdentityCenterArn),
 			))
 		}
 	}
@@ -174,8 +181,11 @@ func sweepAccessGrantsLocations(region string) error {
 		}
 
 		for _, v := range page.AccessGrantsLocationsList {
+			locationID := aws.ToString(v.AccessGrantsLocationId)
 			sweepResources = append(sweepResources, framework.NewSweepResource(newAccessGrantsLocationResource, client,
-				framework.NewAttribute("id", fmt.Sprintf("%s%s%s", accountID, flex.ResourceIdSeparator, aws.ToString(v.AccessGrantsLocationId))),
+				framework.NewAttribute("id", fmt.Sprintf("%s%s%s", accountID, flex.ResourceIdSeparator, locationID)),
+				framework.NewAttribute("access_grants_location_id", locationID),
+				framework.NewAttribute("account_id", accountID),
 			))
 		}
 	}
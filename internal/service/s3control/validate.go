@@ -4,9 +4,16 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func validateS3MultiRegionAccessPointName(v interface{}, k string) (ws []string, errors []error) {
@@ -29,3 +36,165 @@ func validateS3MultiRegionAccessPointName(v interface{}, k string) (ws []string,
 	}
 	return
 }
+
+// validTaggableResourceARN validates that the value is the ARN of an S3 Control
+// resource that supports ListTagsForResource: an S3 Access Grants instance,
+// location or grant, an S3 Storage Lens group, or an access point or
+// Multi-Region Access Point. S3 on Outposts resources can't be tagged.
+func validTaggableResourceARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "s3" {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an S3 Control resource ARN: service must be s3", k, value))
+		return
+	}
+
+	if parsedARN.AccountID == "" {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an S3 Control resource ARN: missing account ID", k, value))
+		return
+	}
+
+	for _, prefix := range []string{"access-grants/", "accesspoint/", "storage-lens-group/"} {
+		if strings.HasPrefix(parsedARN.Resource, prefix) {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not a taggable S3 Control resource ARN: resource must be an access grants instance, location, grant, storage lens group, access point or multi-region access point", k, value))
+
+	return
+}
+
+// validAccessPointARN validates that the value is the ARN of an S3 access point,
+// e.g. an Object Lambda access point's supporting access point. Bucket ARNs are
+// called out, as they're the most common mistake and are otherwise only
+// rejected by the API at apply time.
+func validAccessPointARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service == "s3" && parsedARN.AccountID == "" && !strings.Contains(parsedARN.Resource, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is a bucket ARN: use the ARN of an access point for the bucket, e.g. arn:%s:s3:<region>:<account-id>:accesspoint/<name>", k, value, parsedARN.Partition))
+		return
+	}
+
+	if parsedARN.Service != "s3" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "accesspoint/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an S3 access point ARN", k, value))
+	}
+
+	return
+}
+
+// validateBucketARNPartitionAndRegion checks that an S3 Control Bucket ARN is
+// in the provider's partition and Region. Requests for a bucket elsewhere fail
+// with errors that don't point at the cause, e.g. when an ARN is copied between
+// configurations for different partitions.
+func validateBucketARNPartitionAndRegion(bucketARN arn.ARN, partition, region string) error {
+	if bucketARN.Partition != partition {
+		return fmt.Errorf("ARN partition (%s) does not match the provider partition (%s)", bucketARN.Partition, partition)
+	}
+
+	if bucketARN.Region != region {
+		return fmt.Errorf("ARN Region (%s) does not match the provider Region (%s)", bucketARN.Region, region)
+	}
+
+	return nil
+}
+
+// s3SubPrefixValidator validates that a string Attribute's value is a relative
+// path that stays within an S3 Access Grants location's scope.
+type s3SubPrefixValidator struct{}
+
+func (validator s3SubPrefixValidator) Description(_ context.Context) string {
+	return "value must be a relative path within the location scope, without a leading / or s3:// and without .. segments"
+}
+
+func (validator s3SubPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator s3SubPrefixValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	valid := !strings.HasPrefix(value, "/") && !strings.HasPrefix(strings.ToLower(value), "s3://")
+	for _, segment := range strings.Split(value, "/") {
+		if segment == ".." {
+			valid = false
+			break
+		}
+	}
+
+	if !valid {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			value,
+		))
+	}
+}
+
+// validS3SubPrefix returns a string validator which ensures that any configured
+// attribute value is a relative S3 sub-prefix that can't escape the location scope.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func validS3SubPrefix() validator.String {
+	return s3SubPrefixValidator{}
+}
+
+// validateGranteeIdentifier checks that an access grant's grantee identifier
+// has the shape expected for its grantee type.
+func validateGranteeIdentifier(granteeType awstypes.GranteeType, identifier string) error {
+	switch granteeType {
+	case awstypes.GranteeTypeIam:
+		parsedARN, err := arn.Parse(identifier)
+
+		if err != nil || parsedARN.Service != "iam" || !(strings.HasPrefix(parsedARN.Resource, "role/") || strings.HasPrefix(parsedARN.Resource, "user/")) {
+			return fmt.Errorf("grantee_identifier (%s) must be an IAM user or role ARN when grantee_type is %s", identifier, granteeType)
+		}
+	case awstypes.GranteeTypeDirectoryUser, awstypes.GranteeTypeDirectoryGroup:
+		if !verify.IsIdentityStorePrincipalID(identifier) {
+			return fmt.Errorf("grantee_identifier (%s) must be an IAM Identity Center user or group ID (UUID) when grantee_type is %s", identifier, granteeType)
+		}
+	}
+
+	return nil
+}
+
+// validateAccessGrantS3SubPrefix checks that an access grant's s3_sub_prefix
+// matches its s3_prefix_type. Object-level grants name a single object key,
+// while prefix-level grants may end with a single * wildcard.
+func validateAccessGrantS3SubPrefix(prefixType awstypes.S3PrefixType, subPrefix string) error {
+	switch prefixType {
+	case awstypes.S3PrefixTypeObject:
+		if subPrefix == "" {
+			return fmt.Errorf("s3_sub_prefix must be set to an object key when s3_prefix_type is %s", prefixType)
+		}
+
+		if strings.Contains(subPrefix, "*") {
+			return fmt.Errorf("s3_sub_prefix (%s) must be an object key without wildcards when s3_prefix_type is %s", subPrefix, prefixType)
+		}
+	default:
+		if i := strings.Index(subPrefix, "*"); i >= 0 && i != len(subPrefix)-1 {
+			return fmt.Errorf("s3_sub_prefix (%s) may only contain a single * wildcard at the end, e.g. %s*", subPrefix, strings.TrimRight(subPrefix[:i], "*"))
+		}
+	}
+
+	return nil
+}
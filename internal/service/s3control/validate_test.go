@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidTaggableResourceARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:s3:us-west-2:123456789012:access-grants/default",                                            // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:us-west-2:123456789012:access-grants/default/location/default",                           // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:us-west-2:123456789012:access-grants/default/grant/1d5e5a4b-7d5f-4b3c-9c1a-2b6a7c8d9e0f", // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:s3:us-gov-west-1:123456789012:storage-lens-group/example",                            // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/example",                                              // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",                                            // lintignore:AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validTaggableResourceARN(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid S3 Control resource ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"",
+		"not-an-arn",
+		"arn:aws:s3:::example-bucket", // lintignore:AWSAT005
+		"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:role/example",                                                 // lintignore:AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validTaggableResourceARN(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid S3 Control resource ARN", v)
+		}
+	}
+}
+
+func TestValidAccessPointARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/example",               // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/example-ap", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validAccessPointARN(v, "supporting_access_point")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid access point ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"",
+		"not-an-arn",
+		"arn:aws:s3:us-west-2:123456789012:access-grants/default",                                     // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/example",                         // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:role/example",                                                      // lintignore:AWSAT005
+		"arn:aws:s3:::example-bucket/prefix",                                                          // lintignore:AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validAccessPointARN(v, "supporting_access_point")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid access point ARN", v)
+		}
+	}
+
+	_, errors := validAccessPointARN("arn:aws:s3:::example-bucket", "supporting_access_point") // lintignore:AWSAT005
+	if len(errors) != 1 || !strings.Contains(errors[0].Error(), "is a bucket ARN") {
+		t.Fatalf("expected a bucket ARN error, got: %q", errors)
+	}
+}
+
+func TestValidateBucketARNPartitionAndRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn         string
+		partition   string
+		region      string
+		expectError bool
+	}{
+		"matching": {
+			arn:       "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", // lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+			region:    "us-west-2", // lintignore:AWSAT003
+		},
+		"matching GovCloud": {
+			arn:       "arn:aws-us-gov:s3-outposts:us-gov-west-1:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", // lintignore:AWSAT003,AWSAT005
+			partition: "aws-us-gov",
+			region:    "us-gov-west-1", // lintignore:AWSAT003
+		},
+		"mismatched partition": {
+			arn:         "arn:aws-us-gov:s3-outposts:us-gov-west-1:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", // lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			region:      "us-west-2", // lintignore:AWSAT003
+			expectError: true,
+		},
+		"mismatched Region": {
+			arn:         "arn:aws:s3-outposts:us-east-1:123456789012:outpost/op-01ac5d28a6a232904/bucket/example", // lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			region:      "us-west-2", // lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsedARN, err := arn.Parse(testCase.arn)
+
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			err = validateBucketARNPartitionAndRegion(parsedARN, testCase.partition, testCase.region)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidS3SubPrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	validate := func(v types.String) validator.StringResponse {
+		request := validator.StringRequest{
+			Path:           path.Root("s3_sub_prefix"),
+			PathExpression: path.MatchRoot("s3_sub_prefix"),
+			ConfigValue:    v,
+		}
+		response := validator.StringResponse{}
+		validS3SubPrefix().ValidateString(ctx, request, &response)
+
+		return response
+	}
+
+	validValues := []types.String{
+		types.StringNull(),
+		types.StringUnknown(),
+		types.StringValue("*"),
+		types.StringValue("prefix/*"),
+		types.StringValue("prefix/sub-prefix/object.txt"),
+		types.StringValue("prefix..name/*"),
+	}
+	for _, v := range validValues {
+		if response := validate(v); response.Diagnostics.HasError() {
+			t.Fatalf("%s should be a valid S3 sub-prefix: %v", v, response.Diagnostics)
+		}
+	}
+
+	invalidValues := []types.String{
+		types.StringValue("/prefix/*"),
+		types.StringValue("s3://example-bucket/prefix/*"),
+		types.StringValue("S3://example-bucket/prefix/*"),
+		types.StringValue("../other-prefix/*"),
+		types.StringValue("prefix/../../other-prefix/*"),
+		types.StringValue(".."),
+	}
+	for _, v := range invalidValues {
+		if response := validate(v); !response.Diagnostics.HasError() {
+			t.Fatalf("%s should be an invalid S3 sub-prefix", v)
+		}
+	}
+}
+
+func TestValidateGranteeIdentifier(t *testing.T) {
+	t.Parallel()
+
+	const (
+		iamRoleARN      = "arn:aws:iam::123456789012:role/example"      // lintignore:AWSAT005
+		iamUserARN      = "arn:aws:iam::123456789012:user/path/example" // lintignore:AWSAT005
+		iamGroupARN     = "arn:aws:iam::123456789012:group/example"     // lintignore:AWSAT005
+		s3BucketARN     = "arn:aws:s3:::example"                        // lintignore:AWSAT005
+		directoryID     = "906752a3bc-94f1e788-8f61-4a16-a5cb-3ecc1d2bbb0e"
+		directoryUUID   = "94f1e788-8f61-4a16-a5cb-3ecc1d2bbb0e"
+		directoryNotHex = "example-user"
+	)
+
+	testCases := map[string]struct {
+		granteeType awstypes.GranteeType
+		identifier  string
+		expectError bool
+	}{
+		"IAM role":                   {awstypes.GranteeTypeIam, iamRoleARN, false},
+		"IAM user":                   {awstypes.GranteeTypeIam, iamUserARN, false},
+		"IAM group":                  {awstypes.GranteeTypeIam, iamGroupARN, true},
+		"IAM non-IAM ARN":            {awstypes.GranteeTypeIam, s3BucketARN, true},
+		"IAM directory ID":           {awstypes.GranteeTypeIam, directoryID, true},
+		"DIRECTORY_USER ID":          {awstypes.GranteeTypeDirectoryUser, directoryID, false},
+		"DIRECTORY_USER UUID":        {awstypes.GranteeTypeDirectoryUser, directoryUUID, false},
+		"DIRECTORY_USER IAM ARN":     {awstypes.GranteeTypeDirectoryUser, iamUserARN, true},
+		"DIRECTORY_USER name":        {awstypes.GranteeTypeDirectoryUser, directoryNotHex, true},
+		"DIRECTORY_GROUP ID":         {awstypes.GranteeTypeDirectoryGroup, directoryID, false},
+		"DIRECTORY_GROUP UUID":       {awstypes.GranteeTypeDirectoryGroup, directoryUUID, false},
+		"DIRECTORY_GROUP IAM ARN":    {awstypes.GranteeTypeDirectoryGroup, iamRoleARN, true},
+		"DIRECTORY_GROUP name":       {awstypes.GranteeTypeDirectoryGroup, directoryNotHex, true},
+		"unknown type is not vetted": {awstypes.GranteeType("FUTURE"), directoryNotHex, false},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateGranteeIdentifier(testCase.granteeType, testCase.identifier)
+
+			if err != nil && !testCase.expectError {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectError {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestValidateAccessGrantS3SubPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prefixType  awstypes.S3PrefixType
+		subPrefix   string
+		expectError bool
+	}{
+		"prefix no sub-prefix":          {"", "", false},
+		"prefix all":                    {"", "*", false},
+		"prefix trailing wildcard":      {"", "prefix/*", false},
+		"prefix partial name":           {"", "prefix/data*", false},
+		"prefix no wildcard":            {"", "prefix/data.txt", false},
+		"prefix double wildcard":        {"", "prefix/**", true},
+		"prefix inner wildcard":         {"", "prefix/*/data.txt", true},
+		"Object key":                    {awstypes.S3PrefixTypeObject, "prefix/data.txt", false},
+		"Object no sub-prefix":          {awstypes.S3PrefixTypeObject, "", true},
+		"Object trailing wildcard":      {awstypes.S3PrefixTypeObject, "prefix/*", true},
+		"Object inner wildcard":         {awstypes.S3PrefixTypeObject, "prefix/*/data.txt", true},
+		"Object key with dots in names": {awstypes.S3PrefixTypeObject, "prefix/data.tar.gz", false},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAccessGrantS3SubPrefix(testCase.prefixType, testCase.subPrefix)
+
+			if err != nil && !testCase.expectError {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectError {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
@@ -28,6 +28,10 @@ var accountIDRegexp = regexache.MustCompile(`^(aws|aws-managed|third-party|\d{12
 var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
 var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 
+// Identity Store user and group IDs are UUIDs, optionally prefixed with a
+// 10 character lowercase hexadecimal identity store-specific value.
+var identityStorePrincipalIDRegexp = regexache.MustCompile(`^([0-9a-f]{10}-)?[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+
 // validates all listed in https://gist.github.com/shortjared/4c1e3fe52bdfa47522cfe5b41e5d6f22
 var servicePrincipalRegexp = regexache.MustCompile(`^([0-9a-z-]+\.){1,4}(amazonaws|amazon)\.com$`)
 
@@ -505,6 +509,12 @@ func IsServicePrincipal(value string) (valid bool) {
 	return servicePrincipalRegexp.MatchString(value)
 }
 
+// IsIdentityStorePrincipalID returns whether value is an Identity Store user or
+// group ID.
+func IsIdentityStorePrincipalID(value string) bool {
+	return identityStorePrincipalIDRegexp.MatchString(value)
+}
+
 func MapKeysAre(keyValidators ...schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
@@ -782,6 +782,34 @@ func TestValidServicePrincipal(t *testing.T) {
 	}
 }
 
+func TestIsIdentityStorePrincipalID(t *testing.T) {
+	t.Parallel()
+
+	validIDs := []string{
+		"906715f4-1071-70b6-c5e3-f5ab1a1d9f4a",
+		"906715F4-1071-70B6-C5E3-F5AB1A1D9F4A",
+		"90677bd3a5-1c8d6b1f-4ba6-4e1c-a5b1-7e1cb7b4c7d8",
+	}
+	for _, v := range validIDs {
+		if !IsIdentityStorePrincipalID(v) {
+			t.Fatalf("%q should be a valid Identity Store user or group ID", v)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"d-1234567890",
+		"jdoe",
+		"90677BD3A5-1c8d6b1f-4ba6-4e1c-a5b1-7e1cb7b4c7d8",
+		"906715f4-1071-70b6-c5e3-f5ab1a1d9f4",
+	}
+	for _, v := range invalidIDs {
+		if IsIdentityStorePrincipalID(v) {
+			t.Fatalf("%q should be an invalid Identity Store user or group ID", v)
+		}
+	}
+}
+
 func TestMapLenBetween(t *testing.T) {
 	t.Parallel()
 